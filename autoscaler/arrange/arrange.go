@@ -95,3 +95,10 @@ type Arranger interface {
 	// will be get from the gatherer and the current quantity from the Scaler
 	Arrange(ctx context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error)
 }
+
+// Resetter is implemented by the Arrangers that keep state between the iterations of the
+// autoscaler, the state will be reset when the autoscaler is stopped
+type Resetter interface {
+	// Reset resets the state of the arranger, like a new created one
+	Reset()
+}
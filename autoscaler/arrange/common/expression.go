@@ -0,0 +1,284 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Knetic/govaluate"
+
+	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	exExpressionOpt = "expression"
+	exTimezoneOpt   = "timezone"
+
+	// Variables
+	exInputVar     = "input"
+	exCurrentVar   = "current"
+	exNowHourVar   = "now_hour"
+	exNowMinuteVar = "now_minute"
+	exWeekdayVar   = "weekday"
+
+	// Name
+	expressionRegName = "expression"
+)
+
+// exArgsError is an error of the number or type of the function arguments, these errors
+// don't depend on the values of the variables
+type exArgsError struct {
+	msg string
+}
+
+func (e *exArgsError) Error() string { return e.msg }
+
+// exFloatArgs checks the function arguments are numbers and returns them
+func exFloatArgs(name string, n int, args []interface{}) ([]float64, error) {
+	if n > 0 && len(args) != n {
+		return nil, &exArgsError{fmt.Sprintf("%s function needs %d arguments, got %d", name, n, len(args))}
+	}
+	if len(args) == 0 {
+		return nil, &exArgsError{fmt.Sprintf("%s function needs arguments", name)}
+	}
+
+	fs := make([]float64, len(args))
+	for i, a := range args {
+		f, ok := a.(float64)
+		if !ok {
+			return nil, &exArgsError{fmt.Sprintf("%s function argument %d is not a number: %v", name, i, a)}
+		}
+		fs[i] = f
+	}
+	return fs, nil
+}
+
+// exUnaryFunc creates an expression function of one argument
+func exUnaryFunc(name string, f func(float64) float64) govaluate.ExpressionFunction {
+	return func(args ...interface{}) (interface{}, error) {
+		fs, err := exFloatArgs(name, 1, args)
+		if err != nil {
+			return nil, err
+		}
+		return f(fs[0]), nil
+	}
+}
+
+// exFunctions are the functions that can be used on the expressions
+var exFunctions = map[string]govaluate.ExpressionFunction{
+	"ceil":  exUnaryFunc("ceil", math.Ceil),
+	"floor": exUnaryFunc("floor", math.Floor),
+	"round": exUnaryFunc("round", func(f float64) float64 { return float64(utilmath.RoundInt64(f)) }),
+	"abs":   exUnaryFunc("abs", math.Abs),
+	"min": func(args ...interface{}) (interface{}, error) {
+		fs, err := exFloatArgs("min", 0, args)
+		if err != nil {
+			return nil, err
+		}
+		m := fs[0]
+		for _, f := range fs[1:] {
+			m = math.Min(m, f)
+		}
+		return m, nil
+	},
+	"max": func(args ...interface{}) (interface{}, error) {
+		fs, err := exFloatArgs("max", 0, args)
+		if err != nil {
+			return nil, err
+		}
+		m := fs[0]
+		for _, f := range fs[1:] {
+			m = math.Max(m, f)
+		}
+		return m, nil
+	},
+	"clamp": func(args ...interface{}) (interface{}, error) {
+		fs, err := exFloatArgs("clamp", 3, args)
+		if err != nil {
+			return nil, err
+		}
+		v, min, max := fs[0], fs[1], fs[2]
+		if min > max {
+			return nil, fmt.Errorf("clamp function min (%v) can't be greater than max (%v)", min, max)
+		}
+		return math.Max(min, math.Min(v, max)), nil
+	},
+}
+
+// exCheckFunctionArgs checks the number of arguments of every function on the expression
+// tokens, not only the ones of the branch that would be evaluated. The functions are called
+// with number arguments so only the errors of the number of arguments are returned
+func exCheckFunctionArgs(tokens []govaluate.ExpressionToken) error {
+	for i, t := range tokens {
+		if t.Kind != govaluate.FUNCTION {
+			continue
+		}
+
+		// A function is always followed by its clause, count the arguments of the
+		// clause skipping the separators of the nested clauses
+		n, depth := 0, 1
+		for _, a := range tokens[i+2:] {
+			if a.Kind == govaluate.CLAUSE_CLOSE {
+				depth--
+			}
+			if depth == 0 {
+				break
+			}
+			if depth == 1 && (n == 0 || a.Kind == govaluate.SEPARATOR) {
+				n++
+			}
+			if a.Kind == govaluate.CLAUSE {
+				depth++
+			}
+		}
+
+		args := make([]interface{}, n)
+		for j := range args {
+			args[j] = float64(1)
+		}
+		if _, err := t.Value.(govaluate.ExpressionFunction)(args...); err != nil {
+			if _, ok := err.(*exArgsError); ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Expression arranger will evaluate an arithmetic expression to arrange the new quantity, the
+// expression can use the input, the current quantity and the time as variables, f.e:
+// `clamp(ceil(input / 250) + 1, current - 2, current + 10)`
+type Expression struct {
+	expression *govaluate.EvaluableExpression
+	location   *time.Location   // The timezone of the time variables
+	now        func() time.Time // Clock, used to get the current time
+
+	log *log.Log // custom logger
+}
+
+type expressionCreator struct{}
+
+func (e *expressionCreator) Create(ctx context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
+	return NewExpression(ctx, opts)
+}
+
+// Autoregister on arranger creators
+func init() {
+	arrange.Register(expressionRegName, &expressionCreator{})
+}
+
+// NewExpression will create an Expression arranger
+func NewExpression(ctx context.Context, opts map[string]interface{}) (e *Expression, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+
+	e = &Expression{
+		location: time.UTC,
+		now:      time.Now,
+		log: log.WithFields(log.Fields{
+			"autoscaler": asName,
+			"kind":       "arranger",
+			"name":       expressionRegName,
+		})}
+
+	if v, ok := opts[exTimezoneOpt]; ok {
+		if e.location, err = time.LoadLocation(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", exTimezoneOpt, err)
+		}
+	}
+
+	expr, ok := opts[exExpressionOpt].(string)
+	if !ok || expr == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", exExpressionOpt)
+	}
+	if e.expression, err = govaluate.NewEvaluableExpressionWithFunctions(expr, exFunctions); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", exExpressionOpt, err)
+	}
+
+	// Check the expression before running, unknown variables, wrong function arguments and
+	// not number results would fail on every iteration. The other errors of the sample
+	// evaluation are ignored because they depend on the values (f.e clamp limits)
+	vars := e.variables(types.Quantity{Q: 1}, types.Quantity{Q: 1})
+	for _, v := range e.expression.Vars() {
+		if _, ok := vars[v]; !ok {
+			return nil, fmt.Errorf("%s configuration opt is wrong: unknown %s variable", exExpressionOpt, v)
+		}
+	}
+	if err = exCheckFunctionArgs(e.expression.Tokens()); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", exExpressionOpt, err)
+	}
+	res, evalErr := e.expression.Evaluate(vars)
+	if _, ok := evalErr.(*exArgsError); ok {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", exExpressionOpt, evalErr)
+	}
+	if evalErr == nil {
+		if _, ok := res.(float64); !ok {
+			return nil, fmt.Errorf("%s configuration opt is wrong: result is not a number: %v", exExpressionOpt, res)
+		}
+	}
+
+	return
+}
+
+// variables returns the variables available on the expression
+func (e *Expression) variables(inputQ, currentQ types.Quantity) map[string]interface{} {
+	now := e.now().In(e.location)
+	return map[string]interface{}{
+		exInputVar:     float64(inputQ.Q),
+		exCurrentVar:   float64(currentQ.Q),
+		exNowHourVar:   float64(now.Hour()),
+		exNowMinuteVar: float64(now.Minute()),
+		exWeekdayVar:   float64(now.Weekday()),
+	}
+}
+
+// evaluate evaluates the expression with the variables and returns the result
+func (e *Expression) evaluate(vars map[string]interface{}) (float64, error) {
+	res, err := e.expression.Evaluate(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	f, ok := res.(float64)
+	if !ok {
+		return 0, fmt.Errorf("expression result is not a number: %v", res)
+	}
+	return f, nil
+}
+
+// Arrange will calculate the new quantity evaluating the expression
+func (e *Expression) Arrange(_ context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error) {
+	newQ = types.Quantity{}
+
+	f, err := e.evaluate(e.variables(inputQ, currentQ))
+	if err != nil {
+		return
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		err = fmt.Errorf("expression result is not a valid quantity: %v", f)
+		return
+	}
+
+	newQ.Q = utilmath.RoundInt64(f)
+	if newQ.Q < 0 {
+		newQ.Q = 0
+	}
+	e.log.Debugf("Expression %s result: %v, new arrangement quantity: %s", e.expression, f, newQ)
+
+	return
+}
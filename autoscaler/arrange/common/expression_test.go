@@ -0,0 +1,132 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+func TestExpressionCreation(t *testing.T) {
+	tests := []struct {
+		opts      map[string]interface{}
+		wantError bool
+	}{
+		{opts: map[string]interface{}{exExpressionOpt: "clamp(ceil(input / 250) + 1, current - 2, current + 10)"}},
+		{opts: map[string]interface{}{exExpressionOpt: "now_hour >= 8 && now_hour < 20 && weekday != 0 ? max(input, 10) : input"}},
+		{opts: map[string]interface{}{exExpressionOpt: "clamp(input, 10, current)", exTimezoneOpt: "Europe/Madrid"}},
+		{opts: map[string]interface{}{exExpressionOpt: "5"}},
+		{opts: map[string]interface{}{exExpressionOpt: "max((input), min(current, 5), ceil(2 * (input + 1)))"}},
+		// Missing expression
+		{opts: map[string]interface{}{}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: ""}, wantError: true},
+		// Parse errors
+		{opts: map[string]interface{}{exExpressionOpt: "ceil(input / 250"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "input +* 2"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "sqrt(input)"}, wantError: true},
+		// Unknown variables
+		{opts: map[string]interface{}{exExpressionOpt: "ceil(inputs / 250)"}, wantError: true},
+		// Not a number
+		{opts: map[string]interface{}{exExpressionOpt: "input > 250"}, wantError: true},
+		// Wrong function arguments
+		{opts: map[string]interface{}{exExpressionOpt: "ceil(input, 2)"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "clamp(input, 1)"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "max()"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "floor(input > 2)"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "input + round(current, 2, 3)"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "input > 100 ? ceil(input, 2) : input"}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "input < 0 ? max(input, clamp((current), 1)) : input"}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{exExpressionOpt: 5}, wantError: true},
+		{opts: map[string]interface{}{exExpressionOpt: "input", exTimezoneOpt: "Europe/Nowhere"}, wantError: true},
+	}
+
+	for _, test := range tests {
+		_, err := NewExpression(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+	}
+}
+
+func TestExpressionArrange(t *testing.T) {
+	// Monday 2024-01-15 10:30 UTC
+	monday := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	// Sunday 2024-01-21 10:30 UTC
+	sunday := time.Date(2024, 1, 21, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		timezone   string
+		now        time.Time
+		inputQ     int64
+		currentQ   int64
+
+		wantQ     int64
+		wantError bool
+	}{
+		{"clamp(ceil(input / 250) + 1, current - 2, current + 10)", "", monday, 1000, 5, 5, false},
+		{"clamp(ceil(input / 250) + 1, current - 2, current + 10)", "", monday, 1001, 5, 6, false},
+		{"clamp(ceil(input / 250) + 1, current - 2, current + 10)", "", monday, 10000, 5, 15, false},
+		{"clamp(ceil(input / 250) + 1, current - 2, current + 10)", "", monday, 0, 5, 3, false},
+		{"floor(input / 3)", "", monday, 10, 0, 3, false},
+		{"input / 4", "", monday, 10, 0, 3, false}, // Rounds
+		{"round(input / 4) * 2", "", monday, 10, 0, 6, false},
+		{"abs(input - current)", "", monday, 3, 10, 7, false},
+		{"min(input, current, 4)", "", monday, 5, 10, 4, false},
+		{"max(input, current, 4)", "", monday, 5, 10, 10, false},
+		{"input - current", "", monday, 5, 10, 0, false}, // Never negative
+		{"input % 7", "", monday, 10, 0, 3, false},
+		// Time variables
+		{"now_hour * 100 + now_minute", "", monday, 0, 0, 1030, false},
+		{"now_hour * 100 + now_minute", "Europe/Madrid", monday, 0, 0, 1130, false},
+		{"weekday", "", monday, 0, 0, 1, false},
+		{"weekday", "", sunday, 0, 0, 0, false},
+		{"weekday >= 1 && weekday <= 5 ? max(input, 10) : input", "", monday, 3, 0, 10, false},
+		{"weekday >= 1 && weekday <= 5 ? max(input, 10) : input", "", sunday, 3, 0, 3, false},
+		// Errors
+		{"clamp(input, 10, current)", "", monday, 3, 5, 0, true},
+		{"input / current", "", monday, 3, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{exExpressionOpt: test.expression}
+		if test.timezone != "" {
+			opts[exTimezoneOpt] = test.timezone
+		}
+		e, err := NewExpression(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		e.log = log.New()
+		now := test.now
+		e.now = func() time.Time { return now }
+
+		newQ, err := e.Arrange(context.TODO(), types.Quantity{Q: test.inputQ}, types.Quantity{Q: test.currentQ})
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Arrange should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Arrange shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if newQ.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong arrange result, want: %v; got %v", test, test.wantQ, newQ.Q)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	mwSizeOpt        = "size"
+	mwWindowOpt      = "window"
+	mwAggregationOpt = "aggregation"
+	mwAlphaOpt       = "alpha"
+	mwPercentileOpt  = "percentile"
+	mwThenOpt        = "then"
+
+	// Then opts
+	mwThenKindOpt   = "kind"
+	mwThenConfigOpt = "config"
+
+	// Aggregations
+	mwAggAvg        = "avg"
+	mwAggEWMA       = "ewma"
+	mwAggMedian     = "median"
+	mwAggMax        = "max"
+	mwAggPercentile = "percentile"
+
+	// Name
+	movingWindowRegName = "moving_window"
+)
+
+// mwValue is a gathered value of the window
+type mwValue struct {
+	t time.Time
+	v float64
+}
+
+// MovingWindow arranger keeps the last received input values (by number of values and/or
+// time) and arranges the aggregation of them (average, EWMA, median, max or percentile),
+// this way the spikes of the input are smoothed. Optionally the aggregation can be passed
+// as the input to other arranger.
+type MovingWindow struct {
+	size        int           // The maximum number of values of the window, 0 means no limit
+	window      time.Duration // The maximum age of the values of the window, 0 means no limit
+	aggregation string
+	alpha       float64 // The smoothing factor of the EWMA
+	percentile  float64
+
+	then arrange.Arranger // The arranger that will receive the aggregation as input
+
+	mu     sync.Mutex // Protects the values, can be reset while arranging
+	values []mwValue  // The window, oldest first
+
+	now func() time.Time // Clock, used to get the current time
+	log *log.Log         // custom logger
+}
+
+type movingWindowCreator struct{}
+
+func (m *movingWindowCreator) Create(ctx context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
+	return NewMovingWindow(ctx, opts)
+}
+
+// Autoregister on arranger creators
+func init() {
+	arrange.Register(movingWindowRegName, &movingWindowCreator{})
+}
+
+// NewMovingWindow will create a MovingWindow arranger
+func NewMovingWindow(ctx context.Context, opts map[string]interface{}) (m *MovingWindow, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+
+	m = &MovingWindow{
+		aggregation: mwAggAvg,
+		now:         time.Now,
+		log: log.WithFields(log.Fields{
+			"autoscaler": asName,
+			"kind":       "arranger",
+			"name":       movingWindowRegName,
+		})}
+
+	// Window limits
+	if v, ok := opts[mwSizeOpt]; ok {
+		m.size = int(types.I2Int64(v))
+		if m.size <= 0 {
+			return nil, fmt.Errorf("%s configuration opt must be higher than 0", mwSizeOpt)
+		}
+	}
+	if v, ok := opts[mwWindowOpt]; ok {
+		if m.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", mwWindowOpt, err)
+		}
+		if m.window <= 0 {
+			return nil, fmt.Errorf("%s configuration opt must be positive", mwWindowOpt)
+		}
+	}
+	if m.size == 0 && m.window == 0 {
+		return nil, fmt.Errorf("%s or %s configuration opt is required", mwSizeOpt, mwWindowOpt)
+	}
+
+	// Aggregation
+	if v, ok := opts[mwAggregationOpt]; ok {
+		m.aggregation = v.(string)
+	}
+	switch m.aggregation {
+	case mwAggAvg, mwAggMedian, mwAggMax:
+	case mwAggEWMA:
+		v, ok := opts[mwAlphaOpt]
+		if !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", mwAlphaOpt)
+		}
+		m.alpha = types.I2Float64(v)
+		if m.alpha <= 0 || m.alpha > 1 {
+			return nil, fmt.Errorf("%s configuration opt must be between 0 (exclusive) and 1", mwAlphaOpt)
+		}
+	case mwAggPercentile:
+		v, ok := opts[mwPercentileOpt]
+		if !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", mwPercentileOpt)
+		}
+		m.percentile = types.I2Float64(v)
+		if m.percentile <= 0 || m.percentile > 100 {
+			return nil, fmt.Errorf("%s configuration opt must be between 0 (exclusive) and 100", mwPercentileOpt)
+		}
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s is not a valid aggregation", mwAggregationOpt, m.aggregation)
+	}
+
+	// Chained arranger
+	if v, ok := opts[mwThenOpt]; ok {
+		then := v.(map[interface{}]interface{})
+		kind, ok := then[mwThenKindOpt].(string)
+		if !ok || kind == "" {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s is required", mwThenOpt, mwThenKindOpt)
+		}
+		cfg := map[string]interface{}{}
+		if c, ok := then[mwThenConfigOpt]; ok && c != nil {
+			for k, v := range c.(map[interface{}]interface{}) {
+				cfg[k.(string)] = v
+			}
+		}
+		if m.then, err = arrange.Create(ctx, kind, cfg); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", mwThenOpt, err)
+		}
+	}
+
+	return
+}
+
+// aggregate returns the aggregation of the values
+func (m *MovingWindow) aggregate(vs []float64) float64 {
+	switch m.aggregation {
+	case mwAggEWMA:
+		res := vs[0]
+		for _, v := range vs[1:] {
+			res = m.alpha*v + (1-m.alpha)*res
+		}
+		return res
+	case mwAggMax:
+		res := vs[0]
+		for _, v := range vs[1:] {
+			res = math.Max(res, v)
+		}
+		return res
+	case mwAggMedian:
+		s := append([]float64{}, vs...)
+		sort.Float64s(s)
+		if len(s)%2 == 0 {
+			return (s[len(s)/2-1] + s[len(s)/2]) / 2
+		}
+		return s[len(s)/2]
+	case mwAggPercentile:
+		// Nearest rank
+		s := append([]float64{}, vs...)
+		sort.Float64s(s)
+		i := int(math.Ceil(m.percentile/100*float64(len(s)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return s[i]
+	}
+
+	var sum float64
+	for _, v := range vs {
+		sum += v
+	}
+	return sum / float64(len(vs))
+}
+
+// Arrange will add the input to the window and arrange the aggregation of the window
+func (m *MovingWindow) Arrange(ctx context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error) {
+	m.mu.Lock()
+	now := m.now()
+	m.values = append(m.values, mwValue{t: now, v: float64(inputQ.Q)})
+
+	// Remove the values out of the window
+	first := 0
+	if m.size > 0 && len(m.values) > m.size {
+		first = len(m.values) - m.size
+	}
+	if m.window > 0 {
+		for first < len(m.values)-1 && now.Sub(m.values[first].t) >= m.window {
+			first++
+		}
+	}
+	m.values = m.values[first:]
+
+	vs := make([]float64, len(m.values))
+	for i, v := range m.values {
+		vs[i] = v.v
+	}
+	m.mu.Unlock()
+
+	newQ = types.Quantity{Q: utilmath.RoundInt64(m.aggregate(vs))}
+	m.log.Debugf("%s of %d values window: %s", m.aggregation, len(vs), newQ)
+
+	if m.then != nil {
+		return m.then.Arrange(ctx, newQ, currentQ)
+	}
+	return
+}
+
+// Reset empties the window and resets the chained arranger
+func (m *MovingWindow) Reset() {
+	m.mu.Lock()
+	m.values = nil
+	m.mu.Unlock()
+
+	if r, ok := m.then.(arrange.Resetter); ok {
+		r.Reset()
+	}
+}
@@ -0,0 +1,228 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+func TestMovingWindowCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantSize        int
+		wantWindow      time.Duration
+		wantAggregation string
+		wantThen        bool
+		wantError       bool
+	}{
+		{
+			opts:            map[string]interface{}{mwSizeOpt: 5},
+			wantSize:        5,
+			wantAggregation: mwAggAvg,
+		},
+		{
+			opts:            map[string]interface{}{mwWindowOpt: "5m", mwAggregationOpt: "ewma", mwAlphaOpt: 0.3},
+			wantWindow:      5 * time.Minute,
+			wantAggregation: mwAggEWMA,
+		},
+		{
+			opts: map[string]interface{}{
+				mwSizeOpt:        10,
+				mwWindowOpt:      "5m",
+				mwAggregationOpt: "percentile",
+				mwPercentileOpt:  90,
+				mwThenOpt: map[interface{}]interface{}{
+					mwThenKindOpt: "constant_factor",
+					mwThenConfigOpt: map[interface{}]interface{}{
+						cfFactorOpt:    200,
+						cfRoundTypeOpt: "ceil",
+					},
+				},
+			},
+			wantSize:        10,
+			wantWindow:      5 * time.Minute,
+			wantAggregation: mwAggPercentile,
+			wantThen:        true,
+		},
+		// Missing window
+		{opts: map[string]interface{}{mwAggregationOpt: "max"}, wantError: true},
+		// Wrong window
+		{opts: map[string]interface{}{mwSizeOpt: 0}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: "5"}, wantError: true},
+		{opts: map[string]interface{}{mwWindowOpt: "5"}, wantError: true},
+		{opts: map[string]interface{}{mwWindowOpt: "-5m"}, wantError: true},
+		// Wrong aggregation
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "sum"}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "ewma"}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "ewma", mwAlphaOpt: 1.5}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "percentile"}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "percentile", mwPercentileOpt: 0}, wantError: true},
+		// Wrong chained arranger
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwThenOpt: map[interface{}]interface{}{}}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwThenOpt: map[interface{}]interface{}{mwThenKindOpt: "missing"}}, wantError: true},
+		{opts: map[string]interface{}{mwSizeOpt: 5, mwThenOpt: map[interface{}]interface{}{mwThenKindOpt: "constant_factor"}}, wantError: true},
+	}
+
+	for _, test := range tests {
+		m, err := NewMovingWindow(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if m.size != test.wantSize || m.window != test.wantWindow || m.aggregation != test.wantAggregation || (m.then != nil) != test.wantThen {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v, %v, %v; got %v, %v, %v, %v", test,
+				test.wantSize, test.wantWindow, test.wantAggregation, test.wantThen,
+				m.size, m.window, m.aggregation, m.then != nil)
+		}
+	}
+}
+
+func TestMovingWindowArrange(t *testing.T) {
+	// A spiky queue, every value is received 30s after the previous one
+	spiky := []int64{100, 120, 2000, 110, 90, 1800, 100}
+
+	tests := []struct {
+		name   string
+		opts   map[string]interface{}
+		inputs []int64
+
+		wantQs []int64
+	}{
+		{
+			name:   "average of last 3",
+			opts:   map[string]interface{}{mwSizeOpt: 3},
+			inputs: spiky,
+			wantQs: []int64{100, 110, 740, 743, 733, 667, 663},
+		},
+		{
+			name:   "average of last 90s",
+			opts:   map[string]interface{}{mwWindowOpt: "90s"},
+			inputs: spiky,
+			wantQs: []int64{100, 110, 740, 743, 733, 667, 663},
+		},
+		{
+			name:   "average of last 2 on 90s",
+			opts:   map[string]interface{}{mwSizeOpt: 2, mwWindowOpt: "90s"},
+			inputs: spiky,
+			wantQs: []int64{100, 110, 1060, 1055, 100, 945, 950},
+		},
+		{
+			name:   "median of last 5",
+			opts:   map[string]interface{}{mwSizeOpt: 5, mwAggregationOpt: "median"},
+			inputs: spiky,
+			wantQs: []int64{100, 110, 120, 115, 110, 120, 110},
+		},
+		{
+			name:   "max of last 3",
+			opts:   map[string]interface{}{mwSizeOpt: 3, mwAggregationOpt: "max"},
+			inputs: spiky,
+			wantQs: []int64{100, 120, 2000, 2000, 2000, 1800, 1800},
+		},
+		{
+			name:   "p75 of last 4",
+			opts:   map[string]interface{}{mwSizeOpt: 4, mwAggregationOpt: "percentile", mwPercentileOpt: 75},
+			inputs: spiky,
+			wantQs: []int64{100, 120, 2000, 120, 120, 1800, 110},
+		},
+		{
+			name:   "ewma of last 4",
+			opts:   map[string]interface{}{mwSizeOpt: 4, mwAggregationOpt: "ewma", mwAlphaOpt: 0.5},
+			inputs: []int64{100, 200, 400, 800, 0},
+			// 100; 150; 275; 537.5; (200, 400, 800, 0) -> 200, 300, 550, 275
+			wantQs: []int64{100, 150, 275, 538, 275},
+		},
+		{
+			name: "average chained with constant factor",
+			opts: map[string]interface{}{
+				mwSizeOpt: 3,
+				mwThenOpt: map[interface{}]interface{}{
+					mwThenKindOpt: "constant_factor",
+					mwThenConfigOpt: map[interface{}]interface{}{
+						cfFactorOpt:    100,
+						cfRoundTypeOpt: "ceil",
+					},
+				},
+			},
+			inputs: spiky,
+			wantQs: []int64{1, 2, 8, 8, 8, 7, 7},
+		},
+	}
+
+	for _, test := range tests {
+		m, err := NewMovingWindow(context.TODO(), test.opts)
+		if err != nil {
+			t.Fatalf("\n- %s\n  Creation shouldn't give error: %v", test.name, err)
+		}
+		m.log = log.New()
+		now := time.Now()
+		m.now = func() time.Time { return now }
+
+		for i, in := range test.inputs {
+			newQ, err := m.Arrange(context.TODO(), types.Quantity{Q: in}, types.Quantity{Q: 5})
+			if err != nil {
+				t.Errorf("\n- %s[%d]\n  Arrange shouldn't give error: %v", test.name, i, err)
+				continue
+			}
+			if newQ.Q != test.wantQs[i] {
+				t.Errorf("\n- %s[%d]\n  Wrong arrange result, want: %v; got %v", test.name, i, test.wantQs[i], newQ.Q)
+			}
+			now = now.Add(30 * time.Second)
+		}
+	}
+}
+
+func TestMovingWindowReset(t *testing.T) {
+	m, err := NewMovingWindow(context.TODO(), map[string]interface{}{
+		mwSizeOpt: 3,
+		mwThenOpt: map[interface{}]interface{}{
+			mwThenKindOpt:   "pid",
+			mwThenConfigOpt: map[interface{}]interface{}{pidSetpointOpt: 100, pidKiOpt: 0.01},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+	m.log = log.New()
+	now := time.Now()
+	m.now = func() time.Time { return now }
+	pid := m.then.(*PID)
+	pid.log = log.New()
+	pid.now = m.now
+
+	for _, in := range []int64{1000, 1000, 1000} {
+		if _, err := m.Arrange(context.TODO(), types.Quantity{Q: in}, types.Quantity{Q: 5}); err != nil {
+			t.Fatalf("Arrange shouldn't give error: %v", err)
+		}
+		now = now.Add(30 * time.Second)
+	}
+
+	m.Reset()
+	if len(m.values) != 0 {
+		t.Errorf("Window should be empty after reset, got: %v", m.values)
+	}
+	if pid.started || pid.integral != 0 {
+		t.Errorf("Chained arranger should be reset, it wasn't")
+	}
+
+	// After the reset the old values are not used and the controller starts from the current quantity
+	newQ, err := m.Arrange(context.TODO(), types.Quantity{Q: 100}, types.Quantity{Q: 7})
+	if err != nil {
+		t.Fatalf("Arrange shouldn't give error: %v", err)
+	}
+	if newQ.Q != 7 {
+		t.Errorf("Wrong arrange result after reset, want: %v; got %v", 7, newQ.Q)
+	}
+}
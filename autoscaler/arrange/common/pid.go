@@ -0,0 +1,208 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+// PID arranger is a PID controller that will arrange the quantity required to keep the input
+// on a setpoint. The error is the difference between the input and the setpoint (positive
+// when the input is above the setpoint, so it scales up), and the quantity is:
+//
+//	bias + kp * error + ki * sum(error * dt) + kd * (error - lastError) / dt
+//
+// The bias is the current quantity when the controller starts, this way the first iterations
+// don't jump to a different quantity. The time is measured in seconds between iterations.
+type PID struct {
+	setpoint float64 // The value the input should have
+	kp       float64 // Proportional gain
+	ki       float64 // Integral gain
+	kd       float64 // Derivative gain
+	inverse  bool    // Scale up when the input is below the setpoint
+
+	integralMin *float64 // The minimum of the integral term (ki * sum(error * dt)), avoids integral windup
+	integralMax *float64 // The maximum of the integral term (ki * sum(error * dt)), avoids integral windup
+	outputMin   *float64 // The minimum quantity that will be arranged
+	outputMax   *float64 // The maximum quantity that will be arranged
+
+	// Controller state between iterations
+	mu        sync.Mutex // Protects the state, can be reset while arranging
+	started   bool
+	bias      float64   // The quantity when the controller started
+	integral  float64   // The integral term (already multiplied by the integral gain)
+	lastError float64   // The error of the last iteration
+	lastTime  time.Time // The time of the last iteration
+
+	now func() time.Time // Clock, used to get the current time
+	log *log.Log         // custom logger
+}
+
+const (
+	// Opts
+	pidSetpointOpt    = "setpoint"
+	pidKpOpt          = "kp"
+	pidKiOpt          = "ki"
+	pidKdOpt          = "kd"
+	pidInverseOpt     = "inverse"
+	pidIntegralMinOpt = "integral_min"
+	pidIntegralMaxOpt = "integral_max"
+	pidOutputMinOpt   = "output_min"
+	pidOutputMaxOpt   = "output_max"
+
+	// Name
+	pidRegName = "pid"
+)
+
+type pidCreator struct{}
+
+func (p *pidCreator) Create(ctx context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
+	return NewPID(ctx, opts)
+}
+
+// Autoregister on arranger creators
+func init() {
+	arrange.Register(pidRegName, &pidCreator{})
+}
+
+// NewPID will create a PID arranger
+func NewPID(ctx context.Context, opts map[string]interface{}) (p *PID, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+
+	p = &PID{
+		now: time.Now,
+		log: log.WithFields(log.Fields{
+			"autoscaler": asName,
+			"kind":       "arranger",
+			"name":       pidRegName,
+		})}
+
+	// Set each option with the correct type
+	v, ok := opts[pidSetpointOpt]
+	if !ok {
+		return nil, fmt.Errorf("%s configuration opt is required", pidSetpointOpt)
+	}
+	p.setpoint = types.I2Float64(v)
+
+	// Gains
+	gains := map[string]*float64{pidKpOpt: &p.kp, pidKiOpt: &p.ki, pidKdOpt: &p.kd}
+	for opt, g := range gains {
+		if v, ok := opts[opt]; ok {
+			*g = types.I2Float64(v)
+		}
+		if *g < 0 {
+			return nil, fmt.Errorf("%s configuration opt can't be negative", opt)
+		}
+	}
+	if p.kp == 0 && p.ki == 0 && p.kd == 0 {
+		return nil, fmt.Errorf("at least one of %s, %s or %s configuration opts is required", pidKpOpt, pidKiOpt, pidKdOpt)
+	}
+
+	if v, ok := opts[pidInverseOpt]; ok {
+		p.inverse = v.(bool)
+	}
+
+	// Limits
+	limits := map[string]**float64{
+		pidIntegralMinOpt: &p.integralMin,
+		pidIntegralMaxOpt: &p.integralMax,
+		pidOutputMinOpt:   &p.outputMin,
+		pidOutputMaxOpt:   &p.outputMax,
+	}
+	for opt, l := range limits {
+		if v, ok := opts[opt]; ok {
+			f := types.I2Float64(v)
+			*l = &f
+		}
+	}
+	if p.integralMin != nil && p.integralMax != nil && *p.integralMin >= *p.integralMax {
+		return nil, fmt.Errorf("%s configuration opt can't be greater or equal as %s", pidIntegralMinOpt, pidIntegralMaxOpt)
+	}
+	if p.outputMin != nil && p.outputMax != nil && *p.outputMin >= *p.outputMax {
+		return nil, fmt.Errorf("%s configuration opt can't be greater or equal as %s", pidOutputMinOpt, pidOutputMaxOpt)
+	}
+	if p.outputMin != nil && *p.outputMin < 0 {
+		return nil, fmt.Errorf("%s configuration opt can't be negative", pidOutputMinOpt)
+	}
+
+	return
+}
+
+// clamp limits the value between the limits, if present
+func clamp(v float64, min, max *float64) float64 {
+	if min != nil && v < *min {
+		return *min
+	}
+	if max != nil && v > *max {
+		return *max
+	}
+	return v
+}
+
+// Arrange will calculate the new quantity with the PID controller
+func (p *PID) Arrange(_ context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	newQ = types.Quantity{}
+	now := p.now()
+
+	e := float64(inputQ.Q) - p.setpoint
+	if p.inverse {
+		e = -e
+	}
+
+	// The first iteration starts from the current quantity, there isn't time to integrate
+	// or derivate
+	var derivative float64
+	if !p.started {
+		p.started = true
+		p.bias = float64(currentQ.Q)
+		p.integral = 0
+	} else {
+		dt := now.Sub(p.lastTime).Seconds()
+		if dt > 0 {
+			p.integral = clamp(p.integral+p.ki*e*dt, p.integralMin, p.integralMax)
+			derivative = p.kd * (e - p.lastError) / dt
+		}
+	}
+	p.lastError = e
+	p.lastTime = now
+
+	proportional := p.kp * e
+	output := clamp(p.bias+proportional+p.integral+derivative, p.outputMin, p.outputMax)
+	output = math.Max(output, 0)
+
+	newQ.Q = utilmath.RoundInt64(output)
+	p.log.Debugf("PID error %.2f: P %.2f, I %.2f, D %.2f; new arrangement quantity: %s", e, proportional, p.integral, derivative, newQ)
+
+	return
+}
+
+// Reset resets the state of the controller, the next iteration will start again from the current quantity
+func (p *PID) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.started = false
+	p.integral = 0
+	p.lastError = 0
+}
@@ -0,0 +1,214 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+func TestPIDCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantSetpoint float64
+		wantError    bool
+	}{
+		{
+			opts:         map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.01},
+			wantSetpoint: 300,
+		},
+		{
+			opts: map[string]interface{}{
+				pidSetpointOpt:    0.75,
+				pidKpOpt:          2,
+				pidKiOpt:          0.1,
+				pidKdOpt:          0.5,
+				pidInverseOpt:     true,
+				pidIntegralMinOpt: -10,
+				pidIntegralMaxOpt: 10.5,
+				pidOutputMinOpt:   1,
+				pidOutputMaxOpt:   50,
+			},
+			wantSetpoint: 0.75,
+		},
+		// Missing setpoint
+		{opts: map[string]interface{}{pidKpOpt: 0.01}, wantError: true},
+		// Missing gains
+		{opts: map[string]interface{}{pidSetpointOpt: 300}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{pidSetpointOpt: "300", pidKpOpt: 0.01}, wantError: true},
+		{opts: map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: -0.01}, wantError: true},
+		{opts: map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.01, pidInverseOpt: "true"}, wantError: true},
+		{opts: map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.01, pidIntegralMinOpt: 10, pidIntegralMaxOpt: 10}, wantError: true},
+		{opts: map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.01, pidOutputMinOpt: 10, pidOutputMaxOpt: 5}, wantError: true},
+		{opts: map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.01, pidOutputMinOpt: -1}, wantError: true},
+	}
+
+	for _, test := range tests {
+		p, err := NewPID(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if p.setpoint != test.wantSetpoint {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantSetpoint, p.setpoint)
+		}
+	}
+}
+
+func TestPIDArrange(t *testing.T) {
+	tests := []struct {
+		name string
+		opts map[string]interface{}
+		// Every iteration is 10s after the previous one
+		inputs   []int64
+		currents []int64
+
+		wantQs []int64
+	}{
+		{
+			name:     "proportional",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.02},
+			inputs:   []int64{300, 400, 500, 200, 300},
+			currents: []int64{10, 10, 12, 14, 8},
+			// bias (10) + 0.02 * error
+			wantQs: []int64{10, 12, 14, 8, 10},
+		},
+		{
+			name:     "inverse proportional",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.02, pidInverseOpt: true},
+			inputs:   []int64{300, 400, 200},
+			currents: []int64{10, 10, 8},
+			wantQs:   []int64{10, 8, 12},
+		},
+		{
+			name:     "integral",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKiOpt: 0.001},
+			inputs:   []int64{400, 400, 400, 300, 100, 100},
+			currents: []int64{10, 10, 11, 12, 12, 12},
+			// integral adds 0.001 * error * 10s each iteration, the first one doesn't integrate
+			wantQs: []int64{10, 11, 12, 12, 10, 8},
+		},
+		{
+			name:     "integral with windup limits",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKiOpt: 0.001, pidIntegralMinOpt: -1, pidIntegralMaxOpt: 2},
+			inputs:   []int64{400, 600, 600, 600, 200, 200, 200, 200},
+			currents: []int64{10, 10, 13, 13, 13, 13, 13, 13},
+			// Without limits the integral would be 3, 6, 9 and it would need a long time to recover
+			wantQs: []int64{10, 12, 12, 12, 11, 10, 9, 9},
+		},
+		{
+			name:     "derivative",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKdOpt: 0.5},
+			inputs:   []int64{300, 400, 400, 300},
+			currents: []int64{10, 10, 15, 10},
+			// 0.5 * error delta / 10s
+			wantQs: []int64{10, 15, 10, 5},
+		},
+		{
+			name:     "output limits",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.02, pidOutputMinOpt: 9, pidOutputMaxOpt: 13},
+			inputs:   []int64{500, 200, 400, 0},
+			currents: []int64{10, 13, 9, 12},
+			wantQs:   []int64{13, 9, 12, 9},
+		},
+		{
+			name:     "never negative",
+			opts:     map[string]interface{}{pidSetpointOpt: 300, pidKpOpt: 0.1},
+			inputs:   []int64{300, 0},
+			currents: []int64{10, 10},
+			wantQs:   []int64{10, 0},
+		},
+	}
+
+	for _, test := range tests {
+		p, err := NewPID(context.TODO(), test.opts)
+		if err != nil {
+			t.Fatalf("\n- %s\n  Creation shouldn't give error: %v", test.name, err)
+		}
+		p.log = log.New()
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		for i, in := range test.inputs {
+			newQ, err := p.Arrange(context.TODO(), types.Quantity{Q: in}, types.Quantity{Q: test.currents[i]})
+			if err != nil {
+				t.Errorf("\n- %s[%d]\n  Arrange shouldn't give error: %v", test.name, i, err)
+				continue
+			}
+			if newQ.Q != test.wantQs[i] {
+				t.Errorf("\n- %s[%d]\n  Wrong arrange result, want: %v; got %v", test.name, i, test.wantQs[i], newQ.Q)
+			}
+			now = now.Add(10 * time.Second)
+		}
+	}
+}
+
+// TestPIDArrangeConvergence runs the arranger against a synthetic service whose latency is
+// inversely proportional to the number of instances and checks that it settles on the
+// number of instances that keeps the latency on the setpoint
+func TestPIDArrangeConvergence(t *testing.T) {
+	tests := []struct {
+		name     string
+		load     float64 // latency = load / instances
+		startQ   int64
+		setpoint int64
+
+		wantQ int64
+	}{
+		{"scale up", 6000, 5, 300, 20},
+		{"scale down", 6000, 60, 300, 20},
+		{"steady", 3000, 10, 300, 10},
+	}
+
+	for _, test := range tests {
+		p, err := NewPID(context.TODO(), map[string]interface{}{
+			pidSetpointOpt:    test.setpoint,
+			pidKpOpt:          0.01,
+			pidKiOpt:          0.001,
+			pidKdOpt:          0.01,
+			pidIntegralMinOpt: -100,
+			pidIntegralMaxOpt: 100,
+			pidOutputMinOpt:   1,
+			pidOutputMaxOpt:   100,
+		})
+		if err != nil {
+			t.Fatalf("\n- %s\n  Creation shouldn't give error: %v", test.name, err)
+		}
+		p.log = log.New()
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		currentQ := types.Quantity{Q: test.startQ}
+		history := []int64{}
+		for i := 0; i < 60; i++ {
+			latency := types.Quantity{Q: int64(test.load / float64(currentQ.Q))}
+			currentQ, err = p.Arrange(context.TODO(), latency, currentQ)
+			if err != nil {
+				t.Fatalf("\n- %s\n  Arrange shouldn't give error: %v", test.name, err)
+			}
+			history = append(history, currentQ.Q)
+			now = now.Add(30 * time.Second)
+		}
+
+		// The last iterations should be stable on the wanted quantity
+		for _, q := range history[len(history)-10:] {
+			if q != test.wantQ {
+				t.Errorf("\n- %s\n  Arranger didn't converge, want: %v; got %v", test.name, test.wantQ, history)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const (
+	// Opts
+	stStepsOpt = "steps"
+
+	// Step opts
+	stStepFromOpt     = "from"
+	stStepToOpt       = "to"
+	stStepAddOpt      = "add"
+	stStepPercentOpt  = "percent"
+	stStepQuantityOpt = "quantity"
+
+	// Name
+	stepRegName = "step"
+)
+
+// stStep is a range of input values (from inclusive, to exclusive) with the adjustment that
+// will be applied when the input is on the range
+type stStep struct {
+	from *int64 // Missing means no lower bound
+	to   *int64 // Missing means no upper bound
+
+	kind  string // The kind of adjustment (add, percent or quantity)
+	value int64
+}
+
+// contains returns true if the value is on the step range
+func (s *stStep) contains(v int64) bool {
+	return (s.from == nil || v >= *s.from) && (s.to == nil || v < *s.to)
+}
+
+// adjust returns the new quantity for the current quantity
+func (s *stStep) adjust(currentQ int64) int64 {
+	switch s.kind {
+	case stStepAddOpt:
+		return currentQ + s.value
+	case stStepPercentOpt:
+		// Round away from zero so the percent adjustments always move the quantity
+		delta := float64(currentQ) * float64(s.value) / 100
+		if delta < 0 {
+			return currentQ - int64(math.Ceil(-delta))
+		}
+		return currentQ + int64(math.Ceil(delta))
+	}
+	return s.value
+}
+
+// String returns the range of the step
+func (s *stStep) String() string {
+	from, to := "-inf", "+inf"
+	if s.from != nil {
+		from = fmt.Sprintf("%d", *s.from)
+	}
+	if s.to != nil {
+		to = fmt.Sprintf("%d", *s.to)
+	}
+	return fmt.Sprintf("[%s, %s)", from, to)
+}
+
+// newStStep creates a step from the step configuration
+func newStStep(opts map[interface{}]interface{}) (*stStep, error) {
+	s := &stStep{}
+
+	if v, ok := opts[stStepFromOpt]; ok {
+		from := types.I2Int64(v)
+		s.from = &from
+	}
+	if v, ok := opts[stStepToOpt]; ok {
+		to := types.I2Int64(v)
+		s.to = &to
+	}
+	if s.from != nil && s.to != nil && *s.from >= *s.to {
+		return nil, fmt.Errorf("%s step opt can't be greater or equal as %s", stStepFromOpt, stStepToOpt)
+	}
+
+	// Only one kind of adjustment
+	for _, k := range []string{stStepAddOpt, stStepPercentOpt, stStepQuantityOpt} {
+		v, ok := opts[k]
+		if !ok {
+			continue
+		}
+		if s.kind != "" {
+			return nil, fmt.Errorf("%s and %s step opts can't be used at the same time", s.kind, k)
+		}
+		s.kind = k
+		s.value = types.I2Int64(v)
+	}
+	if s.kind == "" {
+		return nil, fmt.Errorf("one of %s, %s or %s step opts is required", stStepAddOpt, stStepPercentOpt, stStepQuantityOpt)
+	}
+	if s.kind == stStepQuantityOpt && s.value < 0 {
+		return nil, fmt.Errorf("%s step opt can't be negative", stStepQuantityOpt)
+	}
+
+	return s, nil
+}
+
+// Step arranger will adjust the current quantity based on the range of values where the input
+// is, like the step scaling policies of AWS. Each step has a range of input values and an
+// adjustment: a delta to add, a percent of the current quantity to add or an exact quantity.
+// If the input is not on any of the steps the current quantity is kept
+type Step struct {
+	steps []*stStep // The steps, sorted by range
+
+	log *log.Log // custom logger
+}
+
+type stepCreator struct{}
+
+func (s *stepCreator) Create(ctx context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
+	return NewStep(ctx, opts)
+}
+
+// Autoregister on arranger creators
+func init() {
+	arrange.Register(stepRegName, &stepCreator{})
+}
+
+// NewStep will create a Step arranger
+func NewStep(ctx context.Context, opts map[string]interface{}) (s *Step, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+
+	s = &Step{
+		log: log.WithFields(log.Fields{
+			"autoscaler": asName,
+			"kind":       "arranger",
+			"name":       stepRegName,
+		})}
+
+	stsI, _ := opts[stStepsOpt].([]interface{})
+	if len(stsI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", stStepsOpt)
+	}
+	for i, stI := range stsI {
+		st, err := newStStep(stI.(map[interface{}]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt %d step is wrong: %s", stStepsOpt, i, err)
+		}
+
+		// The steps need to be sorted and can't overlap, only the first one can start on -inf
+		// and only the last one can end on +inf
+		if i > 0 {
+			prev := s.steps[i-1]
+			if prev.to == nil || st.from == nil || *st.from < *prev.to {
+				return nil, fmt.Errorf("%s configuration opt %d step %s overlaps or is not sorted with the previous one %s", stStepsOpt, i, st, prev)
+			}
+		}
+		s.steps = append(s.steps, st)
+	}
+
+	return
+}
+
+// Arrange will calculate the new quantity based on the step of the input
+func (s *Step) Arrange(_ context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error) {
+	newQ = currentQ
+
+	for _, st := range s.steps {
+		if !st.contains(inputQ.Q) {
+			continue
+		}
+
+		newQ.Q = st.adjust(currentQ.Q)
+		if newQ.Q < 0 {
+			newQ.Q = 0
+		}
+		s.log.Debugf("Input %s on step %s (%s: %d), new arrangement quantity: %s", inputQ, st, st.kind, st.value, newQ)
+		return
+	}
+
+	s.log.Debugf("Input %s not on any step, not scaling", inputQ)
+	return
+}
@@ -0,0 +1,185 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+// queueSteps are the steps for a queue: 0-100 removes 1, 1k-5k adds 2 and more than 5k adds 30%
+func queueSteps() map[string]interface{} {
+	return map[string]interface{}{
+		stStepsOpt: []interface{}{
+			map[interface{}]interface{}{stStepFromOpt: 0, stStepToOpt: 100, stStepAddOpt: -1},
+			map[interface{}]interface{}{stStepFromOpt: 1000, stStepToOpt: 5000, stStepAddOpt: 2},
+			map[interface{}]interface{}{stStepFromOpt: 5000, stStepPercentOpt: 30},
+		},
+	}
+}
+
+func TestStepCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantSteps int
+		wantError bool
+	}{
+		{opts: queueSteps(), wantSteps: 3},
+		{
+			opts: map[string]interface{}{
+				stStepsOpt: []interface{}{
+					map[interface{}]interface{}{stStepToOpt: 50, stStepPercentOpt: -20},
+					map[interface{}]interface{}{stStepFromOpt: 50, stStepToOpt: 80, stStepQuantityOpt: 10},
+					map[interface{}]interface{}{stStepFromOpt: 90, stStepQuantityOpt: 20},
+				},
+			},
+			wantSteps: 3,
+		},
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepAddOpt: 1}}},
+			wantSteps: 1,
+		},
+		// Missing steps
+		{opts: map[string]interface{}{}, wantError: true},
+		{opts: map[string]interface{}{stStepsOpt: []interface{}{}}, wantError: true},
+		// Missing adjustment
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepFromOpt: 0, stStepToOpt: 100}}},
+			wantError: true,
+		},
+		// Multiple adjustments
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepFromOpt: 0, stStepAddOpt: 1, stStepPercentOpt: 10}}},
+			wantError: true,
+		},
+		// Wrong params
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepFromOpt: 100, stStepToOpt: 100, stStepAddOpt: 1}}},
+			wantError: true,
+		},
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepFromOpt: "100", stStepAddOpt: 1}}},
+			wantError: true,
+		},
+		{
+			opts:      map[string]interface{}{stStepsOpt: []interface{}{map[interface{}]interface{}{stStepQuantityOpt: -1}}},
+			wantError: true,
+		},
+		// Unsorted steps
+		{
+			opts: map[string]interface{}{
+				stStepsOpt: []interface{}{
+					map[interface{}]interface{}{stStepFromOpt: 1000, stStepToOpt: 5000, stStepAddOpt: 2},
+					map[interface{}]interface{}{stStepFromOpt: 0, stStepToOpt: 100, stStepAddOpt: -1},
+				},
+			},
+			wantError: true,
+		},
+		// Overlapping steps
+		{
+			opts: map[string]interface{}{
+				stStepsOpt: []interface{}{
+					map[interface{}]interface{}{stStepFromOpt: 0, stStepToOpt: 100, stStepAddOpt: -1},
+					map[interface{}]interface{}{stStepFromOpt: 99, stStepToOpt: 5000, stStepAddOpt: 2},
+				},
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				stStepsOpt: []interface{}{
+					map[interface{}]interface{}{stStepFromOpt: 0, stStepAddOpt: -1},
+					map[interface{}]interface{}{stStepFromOpt: 1000, stStepAddOpt: 2},
+				},
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				stStepsOpt: []interface{}{
+					map[interface{}]interface{}{stStepToOpt: 100, stStepAddOpt: -1},
+					map[interface{}]interface{}{stStepToOpt: 1000, stStepAddOpt: 2},
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		s, err := NewStep(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(s.steps) != test.wantSteps {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantSteps, len(s.steps))
+		}
+	}
+}
+
+func TestStepArrange(t *testing.T) {
+	exact := map[string]interface{}{
+		stStepsOpt: []interface{}{
+			map[interface{}]interface{}{stStepToOpt: 50, stStepPercentOpt: -20},
+			map[interface{}]interface{}{stStepFromOpt: 50, stStepToOpt: 80, stStepQuantityOpt: 10},
+			map[interface{}]interface{}{stStepFromOpt: 90, stStepQuantityOpt: 20},
+		},
+	}
+
+	tests := []struct {
+		opts     map[string]interface{}
+		inputQ   int64
+		currentQ int64
+
+		wantQ int64
+	}{
+		{queueSteps(), 0, 10, 9},
+		{queueSteps(), 99, 10, 9},
+		{queueSteps(), 0, 0, 0}, // Never negative
+		{queueSteps(), 100, 10, 10},
+		{queueSteps(), 999, 10, 10},
+		{queueSteps(), 1000, 10, 12},
+		{queueSteps(), 4999, 10, 12},
+		{queueSteps(), 5000, 10, 13},
+		{queueSteps(), 50000, 100, 130},
+		{queueSteps(), 50000, 3, 4}, // Percent rounds away from zero
+		{queueSteps(), -5, 10, 10},
+		{exact, -100, 10, 8},
+		{exact, 49, 3, 2}, // Percent rounds away from zero
+		{exact, 49, 0, 0},
+		{exact, 50, 3, 10},
+		{exact, 79, 30, 10},
+		{exact, 85, 30, 30},
+		{exact, 90, 30, 20},
+		{exact, 10000, 30, 20},
+	}
+
+	for _, test := range tests {
+		s, err := NewStep(context.TODO(), test.opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		s.log = log.New()
+
+		newQ, err := s.Arrange(context.TODO(), types.Quantity{Q: test.inputQ}, types.Quantity{Q: test.currentQ})
+		if err != nil {
+			t.Errorf("\n- %+v\n  Arrange shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if newQ.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong arrange result, want: %v; got %v", test, test.wantQ, newQ.Q)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+// TargetTracking arranges the desired scale to keep the value per unit of capacity on a target
+// F.e for a 200 messages per instance target if we have an input of 1000 messages the result
+// is 5 instances. The input can be the total value (messages on a queue) or the average value
+// per unit of the current capacity (CPU percent), in this case the current quantity is used
+// to get the total value. If the value per unit is inside the tolerance band of the target
+// then the current quantity will be kept
+type TargetTracking struct {
+	target        float64 // The value per unit of capacity we want
+	mode          string  // How is the input received, total or average per unit
+	upTolerance   float64 // The percent over the target that will not scale up
+	downTolerance float64 // The percent under the target that will not scale down
+
+	log *log.Log // custom logger
+}
+
+const (
+	// Opts
+	ttTargetOpt        = "target_per_unit"
+	ttModeOpt          = "mode"
+	ttUpToleranceOpt   = "scaleup_tolerance_percent"
+	ttDownToleranceOpt = "scaledown_tolerance_percent"
+
+	// Modes
+	ttModeTotal   = "total"
+	ttModeAverage = "average"
+
+	// Name
+	targetTrackingRegName = "target_tracking"
+)
+
+type targetTrackingCreator struct{}
+
+func (t *targetTrackingCreator) Create(ctx context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
+	return NewTargetTracking(ctx, opts)
+}
+
+// Autoregister on arranger creators
+func init() {
+	arrange.Register(targetTrackingRegName, &targetTrackingCreator{})
+}
+
+// NewTargetTracking will create a TargetTracking arranger
+func NewTargetTracking(ctx context.Context, opts map[string]interface{}) (t *TargetTracking, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+
+	t = &TargetTracking{
+		mode: ttModeTotal,
+		log: log.WithFields(log.Fields{
+			"autoscaler": asName,
+			"kind":       "arranger",
+			"name":       targetTrackingRegName,
+		})}
+
+	// Set each option with the correct type
+	v, ok := opts[ttTargetOpt]
+	if !ok {
+		return nil, fmt.Errorf("%s configuration opt is required", ttTargetOpt)
+	}
+	t.target = types.I2Float64(v)
+	if t.target <= 0 {
+		return nil, fmt.Errorf("%s configuration opt must be higher than 0", ttTargetOpt)
+	}
+
+	if v, ok := opts[ttModeOpt]; ok {
+		t.mode = v.(string)
+	}
+	switch t.mode {
+	case ttModeTotal, ttModeAverage:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s is not a valid mode", ttModeOpt, t.mode)
+	}
+
+	if v, ok := opts[ttUpToleranceOpt]; ok {
+		t.upTolerance = types.I2Float64(v)
+	}
+	if t.upTolerance < 0 {
+		return nil, fmt.Errorf("%s configuration opt can't be negative", ttUpToleranceOpt)
+	}
+
+	if v, ok := opts[ttDownToleranceOpt]; ok {
+		t.downTolerance = types.I2Float64(v)
+	}
+	if t.downTolerance < 0 || t.downTolerance >= 100 {
+		return nil, fmt.Errorf("%s configuration opt must be between 0 and 100", ttDownToleranceOpt)
+	}
+
+	return
+}
+
+// Arrange will calculate the quantity required to have the target value per unit
+func (t *TargetTracking) Arrange(_ context.Context, inputQ, currentQ types.Quantity) (newQ types.Quantity, err error) {
+	newQ = types.Quantity{}
+
+	// Get the total value of all the capacity
+	total := float64(inputQ.Q)
+	if t.mode == ttModeAverage {
+		if currentQ.Q <= 0 {
+			err = fmt.Errorf("can't get the total value from an average with %d current quantity", currentQ.Q)
+			return
+		}
+		total = total * float64(currentQ.Q)
+	}
+
+	// Inside the tolerance band we don't want to change, small deviations from the target
+	// would flap the quantity
+	if currentQ.Q > 0 {
+		perUnit := total / float64(currentQ.Q)
+		upLimit := t.target * (1 + t.upTolerance/100)
+		downLimit := t.target * (1 - t.downTolerance/100)
+		if perUnit <= upLimit && perUnit >= downLimit {
+			t.log.Debugf("%.2f value per unit inside the tolerance of the target (%.2f - %.2f), keeping current quantity", perUnit, downLimit, upLimit)
+			newQ.Q = currentQ.Q
+			return
+		}
+	}
+
+	newQ.Q = int64(math.Ceil(total / t.target))
+	t.log.Debugf("%.2f total value for %.2f target per unit: %s", total, t.target, newQ)
+
+	return
+}
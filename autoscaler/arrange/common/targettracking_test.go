@@ -0,0 +1,132 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+func TestTargetTrackingCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantTarget float64
+		wantMode   string
+		wantError  bool
+	}{
+		{
+			opts:       map[string]interface{}{ttTargetOpt: 200},
+			wantTarget: 200,
+			wantMode:   ttModeTotal,
+		},
+		{
+			opts: map[string]interface{}{
+				ttTargetOpt:        62.5,
+				ttModeOpt:          "average",
+				ttUpToleranceOpt:   10,
+				ttDownToleranceOpt: 12.5,
+			},
+			wantTarget: 62.5,
+			wantMode:   ttModeAverage,
+		},
+		// Missing target
+		{opts: map[string]interface{}{ttModeOpt: "average"}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{ttTargetOpt: 0}, wantError: true},
+		{opts: map[string]interface{}{ttTargetOpt: "200"}, wantError: true},
+		{opts: map[string]interface{}{ttTargetOpt: 200, ttModeOpt: "sum"}, wantError: true},
+		{opts: map[string]interface{}{ttTargetOpt: 200, ttUpToleranceOpt: -10}, wantError: true},
+		{opts: map[string]interface{}{ttTargetOpt: 200, ttDownToleranceOpt: 100}, wantError: true},
+	}
+
+	for _, test := range tests {
+		tt, err := NewTargetTracking(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if tt.target != test.wantTarget || tt.mode != test.wantMode {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantTarget, test.wantMode, tt.target, tt.mode)
+		}
+	}
+}
+
+func TestTargetTrackingArrange(t *testing.T) {
+	tests := []struct {
+		target        float64
+		mode          string
+		upTolerance   float64
+		downTolerance float64
+		inputQ        int64
+		currentQ      int64
+
+		wantQ     int64
+		wantError bool
+	}{
+		// Total mode
+		{200, ttModeTotal, 0, 0, 1000, 3, 5, false},
+		{200, ttModeTotal, 0, 0, 1001, 3, 6, false},
+		{200, ttModeTotal, 0, 0, 999, 8, 5, false},
+		{200, ttModeTotal, 0, 0, 0, 8, 0, false},
+		{200, ttModeTotal, 0, 0, 1000, 0, 5, false},
+		{200, ttModeTotal, 0, 0, 0, 0, 0, false},
+		// Total mode with tolerance (5 instances: 900 - 1100)
+		{200, ttModeTotal, 10, 10, 1100, 5, 5, false},
+		{200, ttModeTotal, 10, 10, 1101, 5, 6, false},
+		{200, ttModeTotal, 10, 10, 900, 5, 5, false},
+		{200, ttModeTotal, 10, 10, 899, 5, 5, false}, // Out of the band but ceil gives the same
+		{200, ttModeTotal, 10, 10, 799, 5, 4, false},
+		// Average mode
+		{60, ttModeAverage, 0, 0, 90, 10, 15, false},
+		{60, ttModeAverage, 0, 0, 30, 10, 5, false},
+		{60, ttModeAverage, 0, 0, 60, 10, 10, false},
+		{60, ttModeAverage, 0, 0, 61, 10, 11, false},
+		{62.5, ttModeAverage, 0, 0, 50, 5, 4, false},
+		// Average mode with tolerance (54 - 69)
+		{60, ttModeAverage, 15, 10, 61, 10, 10, false},
+		{60, ttModeAverage, 15, 10, 69, 10, 10, false},
+		{60, ttModeAverage, 15, 10, 70, 10, 12, false},
+		{60, ttModeAverage, 15, 10, 54, 10, 10, false},
+		{60, ttModeAverage, 15, 10, 53, 10, 9, false},
+		// Average without capacity
+		{60, ttModeAverage, 0, 0, 90, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		tt := &TargetTracking{
+			target:        test.target,
+			mode:          test.mode,
+			upTolerance:   test.upTolerance,
+			downTolerance: test.downTolerance,
+			log:           log.New(),
+		}
+
+		newQ, err := tt.Arrange(context.TODO(), types.Quantity{Q: test.inputQ}, types.Quantity{Q: test.currentQ})
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Arrange should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Arrange shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if newQ.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong arrange result, want: %v; got %v", test, test.wantQ, newQ.Q)
+		}
+	}
+}
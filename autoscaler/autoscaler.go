@@ -6,7 +6,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/themotion/ladder/autoscaler/arrange"
 	"github.com/themotion/ladder/autoscaler/filter"
+	"github.com/themotion/ladder/autoscaler/gather"
 	"github.com/themotion/ladder/autoscaler/scale"
 	"github.com/themotion/ladder/autoscaler/solve"
 	"github.com/themotion/ladder/config"
@@ -80,6 +82,9 @@ type Autoscaler interface {
 	Running() bool
 	// Status will return the status of the autoscaler
 	Status() (Status, error)
+	// PushInput will push a quantity to the gatherer of an inputter, the gatherer needs to
+	// accept pushed quantities
+	PushInput(inputter string, q types.Quantity) error
 }
 
 // IntervalAutoscaler is the one that has the logic of detecting the downscale/upscale
@@ -461,6 +466,10 @@ func (a *IntervalAutoscaler) Run() error {
 		a.log.Infof("Autoscaler '%s' finished running", a.Name)
 	}()
 
+	// The state of the arrangers is not valid after a stop, the previous loop has already
+	// finished (with its iteration) so the arrangers are not being used
+	a.resetArrangers()
+
 	a.log.Infof("Start running '%s' autoscaler", a.Name)
 	a.startTime = time.Now().UTC()
 	a.intervalT = time.NewTicker(a.Interval)
@@ -601,6 +610,16 @@ func (a *IntervalAutoscaler) Stop(duration time.Duration) error {
 	return nil
 }
 
+// resetArrangers resets the state of the inputters arrangers that have state
+func (a *IntervalAutoscaler) resetArrangers() {
+	for _, in := range a.Inputters {
+		if r, ok := in.arranger.(arrange.Resetter); ok {
+			a.log.Debugf("Resetting '%s' inputter arranger", in.name)
+			r.Reset()
+		}
+	}
+}
+
 // CancelStop will cancel the current stop
 func (a *IntervalAutoscaler) CancelStop() error {
 	// Check is running
@@ -616,6 +635,9 @@ func (a *IntervalAutoscaler) CancelStop() error {
 
 // Running returns true if the autoscaler is running, false if not
 func (a *IntervalAutoscaler) Running() bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
 	return a.running
 }
 
@@ -638,6 +660,24 @@ func (a *IntervalAutoscaler) Status() (Status, error) {
 	return st, nil
 }
 
+// PushInput pushes the quantity to the gatherer of the inputter
+func (a *IntervalAutoscaler) PushInput(inputter string, q types.Quantity) error {
+	for _, in := range a.Inputters {
+		if in.name != inputter {
+			continue
+		}
+
+		p, ok := in.gatherer.(gather.Pusher)
+		if !ok {
+			return fmt.Errorf("%s inputter gatherer doesn't accept pushed values", inputter)
+		}
+		a.log.Infof("Pushing %s to '%s' inputter", q, inputter)
+		return p.Push(q)
+	}
+
+	return fmt.Errorf("%s is not a valid inputter", inputter)
+}
+
 // Check implements the Checker interface (for the healthchecks)
 func (a *IntervalAutoscaler) Check() (string, error) {
 	st, err := a.Status()
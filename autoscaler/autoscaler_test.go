@@ -761,3 +761,142 @@ func TestCorrectScalerWaitTimeout(t *testing.T) {
 
 	}
 }
+
+func TestPushInput(t *testing.T) {
+	tests := []struct {
+		inputter  string
+		q         int64
+		pushError bool
+
+		wantQ     int64
+		wantError bool
+	}{
+		{inputter: "pushed", q: 42, wantQ: 42},
+		{inputter: "pushed", q: 42, pushError: true, wantError: true},
+		// Gatherer doesn't accept pushes
+		{inputter: "gathered", q: 42, wantError: true},
+		// Missing inputter
+		{inputter: "missing", q: 42, wantError: true},
+	}
+
+	for _, test := range tests {
+		pg := &testPushGatherer{retError: test.pushError}
+		a := IntervalAutoscaler{
+			Name: "test",
+			Inputters: []inputter{
+				inputter{name: "gathered", gatherer: &testGatherer{}, log: log.New()},
+				inputter{name: "pushed", gatherer: pg, log: log.New()},
+			},
+			log: log.New(),
+		}
+
+		err := a.PushInput(test.inputter, types.Quantity{Q: test.q})
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  PushInput should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  PushInput shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if pg.q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong pushed value, want: %v; got %v", test, test.wantQ, pg.q.Q)
+		}
+	}
+}
+
+func TestResetArrangers(t *testing.T) {
+	ra1 := &testResetArranger{}
+	ra2 := &testResetArranger{}
+	a := IntervalAutoscaler{
+		Name: "test",
+		Inputters: []inputter{
+			inputter{name: "reset1", arranger: ra1, log: log.New()},
+			inputter{name: "stateless", arranger: &testArranger{}, log: log.New()},
+			inputter{name: "without_arranger", log: log.New()},
+			inputter{name: "reset2", arranger: ra2, log: log.New()},
+		},
+		log: log.New(),
+	}
+
+	a.resetArrangers()
+	if ra1.resets != 1 || ra2.resets != 1 {
+		t.Errorf("Wrong number of arranger resets, want: %v, %v; got %v, %v", 1, 1, ra1.resets, ra2.resets)
+	}
+}
+
+func TestStopResetArrangersAfterIteration(t *testing.T) {
+	registerDummies()
+
+	c := &config.Autoscaler{
+		Name:     "autoscaler-test",
+		Interval: 10 * time.Millisecond,
+		Scale:    config.Block{Kind: "test0"},
+		Solve:    config.Block{Kind: "test0"},
+		Inputters: []config.Inputter{
+			config.Inputter{
+				Name:    "test0_input",
+				Gather:  config.Block{Kind: "test0"},
+				Arrange: config.Block{Kind: "test0"},
+			},
+		},
+	}
+
+	as, err := NewIntervalAutoscaler(c, false)
+	if err != nil {
+		t.Fatalf("\n- IntervalAutoscaler shouldn't give an error: %v", err)
+	}
+	ba := newTestBlockingArranger()
+	as.Inputters[0].arranger = ba
+
+	wait := func(c chan struct{}, msg string) {
+		select {
+		case <-c:
+		case <-time.After(1 * time.Second):
+			t.Fatalf("\n- %s", msg)
+		}
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		as.Run()
+		close(finished)
+	}()
+	wait(ba.resetting, "Arranger wasn't reset when starting")
+	wait(ba.arranging, "Arrangement didn't start")
+
+	// Stop while arranging, the arranger shouldn't be reset until the iteration finishes
+	if err := as.Stop(1 * time.Minute); err != nil {
+		t.Fatalf("\n- IntervalAutoscaler stop shouldn't give error: %v", err)
+	}
+	ba.release <- struct{}{}
+	wait(finished, "IntervalAutoscaler didn't finish after the stop")
+
+	// Start again
+	if err := as.CancelStop(); err != nil {
+		t.Fatalf("\n- IntervalAutoscaler stop cancelation shouldn't give error: %v", err)
+	}
+	wait(ba.resetting, "Arranger wasn't reset when starting again")
+	wait(ba.arranging, "Arrangement didn't start again")
+	if !as.Running() {
+		t.Errorf("\n- IntervalAutoscaler should be running after stop cancelation")
+	}
+
+	ba.mu.Lock()
+	if ba.inFlightResets != 0 {
+		t.Errorf("\n- Arranger shouldn't be reset while arranging, got %d resets", ba.inFlightResets)
+	}
+	// One reset for each run
+	if ba.resets != 2 {
+		t.Errorf("\n- Wrong number of arranger resets, want: %d; got %d", 2, ba.resets)
+	}
+	ba.mu.Unlock()
+
+	// Stop & release the blocked arrangements
+	as.stopForever()
+	close(ba.release)
+}
@@ -3,6 +3,7 @@ package autoscaler
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/themotion/ladder/autoscaler/arrange"
@@ -28,6 +29,24 @@ func (tg *testGatherer) Gather(_ context.Context) (types.Quantity, error) {
 	return types.Quantity{Q: tg.times}, nil
 }
 
+// custom test Gather that accepts pushed quantities
+type testPushGatherer struct {
+	q        types.Quantity
+	retError bool
+}
+
+func (tg *testPushGatherer) Gather(_ context.Context) (types.Quantity, error) {
+	return tg.q, nil
+}
+
+func (tg *testPushGatherer) Push(q types.Quantity) error {
+	if tg.retError {
+		return fmt.Errorf("Error!")
+	}
+	tg.q = q
+	return nil
+}
+
 type testGathererCreator struct{}
 
 func (t *testGathererCreator) Create(_ context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
@@ -55,6 +74,69 @@ func (ta *testArranger) Arrange(_ context.Context, inputQ, currentQ types.Quanti
 	return res, nil
 }
 
+// custom test arrange that counts the resets of its state
+type testResetArranger struct {
+	testArranger
+	resets int
+}
+
+func (ta *testResetArranger) Reset() {
+	ta.resets++
+}
+
+// custom test arrange that blocks the arrangements until released and counts the resets
+// of its state while arranging
+type testBlockingArranger struct {
+	testArranger
+	arranging chan struct{} // Notified when an arrangement starts
+	resetting chan struct{} // Notified when the state is reset
+	release   chan struct{} // Each arrangement finishes when receives from it
+
+	mu             sync.Mutex
+	inFlight       bool
+	resets         int
+	inFlightResets int
+}
+
+func newTestBlockingArranger() *testBlockingArranger {
+	return &testBlockingArranger{
+		arranging: make(chan struct{}, 1),
+		resetting: make(chan struct{}, 1),
+		release:   make(chan struct{}),
+	}
+}
+
+func (ta *testBlockingArranger) Arrange(ctx context.Context, inputQ, currentQ types.Quantity) (types.Quantity, error) {
+	ta.mu.Lock()
+	ta.inFlight = true
+	ta.mu.Unlock()
+
+	select {
+	case ta.arranging <- struct{}{}:
+	default:
+	}
+	<-ta.release
+
+	ta.mu.Lock()
+	ta.inFlight = false
+	ta.mu.Unlock()
+	return ta.testArranger.Arrange(ctx, inputQ, currentQ)
+}
+
+func (ta *testBlockingArranger) Reset() {
+	ta.mu.Lock()
+	ta.resets++
+	if ta.inFlight {
+		ta.inFlightResets++
+	}
+	ta.mu.Unlock()
+
+	select {
+	case ta.resetting <- struct{}{}:
+	default:
+	}
+}
+
 type testArrangerCreator struct{}
 
 func (t *testArrangerCreator) Create(_ context.Context, opts map[string]interface{}) (arrange.Arranger, error) {
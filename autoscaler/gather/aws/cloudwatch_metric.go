@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +13,7 @@ import (
 	"github.com/themotion/ladder/autoscaler/gather"
 	"github.com/themotion/ladder/log"
 	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
 )
 
 const (
@@ -25,6 +27,12 @@ const (
 	cwDimensionsNameOpt  = "name"
 	cwDimensionsValueOpt = "value"
 	cwTimeOffsetOpt      = "offset"
+	cwMetricQueriesOpt   = "metric_queries"
+	cwQueryIDOpt         = "id"
+	cwExpressionOpt      = "expression"
+
+	// The id of the expression query on GetMetricData
+	cwExpressionID = "ladder_expression"
 
 	// the name
 	cwRegName = "aws_cloudwatch_metric"
@@ -38,6 +46,87 @@ type dimension struct {
 	value string
 }
 
+// cwMetricQuery is a metric query of a GetMetricData request
+type cwMetricQuery struct {
+	id         string
+	dimensions []*dimension
+	metricName string
+	namespace  string
+	statistic  string
+	unit       string // Optional on metric queries
+}
+
+// cwQueryIDRegexp are the valid GetMetricData query ids
+var cwQueryIDRegexp = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+
+// parseCwDimensions parses the dimensions configuration
+func parseCwDimensions(d interface{}) ([]*dimension, error) {
+	// type assertions of the dimesions conf
+	lenD := len(d.([]interface{}))
+	td := make([]map[interface{}]interface{}, lenD)
+
+	for i, dd := range d.([]interface{}) {
+		td[i] = dd.(map[interface{}]interface{})
+	}
+
+	dimensions := make([]*dimension, lenD)
+	for i, k := range td {
+		n, ok := k[cwDimensionsNameOpt]
+		if !ok {
+			return nil, fmt.Errorf("%s configuration opt is invalid", cwDimensionsOpt)
+		}
+		v, ok := k[cwDimensionsValueOpt]
+		if !ok {
+			return nil, fmt.Errorf("%s configuration opt is invalid", cwDimensionsOpt)
+		}
+
+		dimensions[i] = &dimension{n.(string), v.(string)}
+	}
+
+	return dimensions, nil
+}
+
+// parseCwMetricQuery parses a metric query configuration of GetMetricData
+func parseCwMetricQuery(opts map[interface{}]interface{}) (*cwMetricQuery, error) {
+	q := &cwMetricQuery{}
+	var ok bool
+	var err error
+
+	if q.id, ok = opts[cwQueryIDOpt].(string); !ok || !cwQueryIDRegexp.MatchString(q.id) || q.id == cwExpressionID {
+		return nil, fmt.Errorf("%s configuration opt of %s is invalid", cwQueryIDOpt, cwMetricQueriesOpt)
+	}
+
+	if q.metricName, ok = opts[cwMetricNameOpt].(string); !ok || q.metricName == "" {
+		return nil, fmt.Errorf("%s configuration opt of %s is required", cwMetricNameOpt, cwMetricQueriesOpt)
+	}
+
+	if q.namespace, ok = opts[cwNamespaceOpt].(string); !ok || q.namespace == "" {
+		return nil, fmt.Errorf("%s configuration opt of %s is required", cwNamespaceOpt, cwMetricQueriesOpt)
+	}
+
+	if q.statistic, ok = opts[cwStatisticOpt].(string); !ok {
+		return nil, fmt.Errorf("%s configuration opt of %s is required", cwStatisticOpt, cwMetricQueriesOpt)
+	}
+	if err = checkCwStatistic(q.statistic); err != nil {
+		return nil, fmt.Errorf("%s configuration opt of %s is invalid", cwStatisticOpt, cwMetricQueriesOpt)
+	}
+
+	if v, ok := opts[cwUnitOpt]; ok {
+		q.unit = v.(string)
+		if err = checkCwUnit(q.unit); err != nil {
+			return nil, fmt.Errorf("%s configuration opt of %s is invalid", cwUnitOpt, cwMetricQueriesOpt)
+		}
+	}
+
+	if d, ok := opts[cwDimensionsOpt]; ok {
+		if q.dimensions, err = parseCwDimensions(d); err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
 // checkCwStatistic checks if is a valid cloudwatch statistic value
 func checkCwStatistic(statistic string) error {
 	switch statistic {
@@ -99,7 +188,11 @@ type CWMetric struct {
 	statistic  string        // statistic (Sum, Maximum, Minimum, SampleCount, Average)
 	unit       string        // unit # Seconds, Microseconds, Milliseconds, Bytes, Kilobytes, Megabytes, Gigabytes, Terabytes, Bits, Kilobits, Megabits, Gigabits, Terabits, Percent, Count, Bytes/Second, Kilobytes/Second, Megabytes/Second, Gigabytes/Second, Terabytes/Second, Bits/Second, Kilobits/Second, Megabits/Second, Gigabits/ Second, Terabits/Second, Count/Second, None
 	offset     time.Duration // The offset to apply to the query, this is usually because AWS doesn't have the values ready for the time now
-	log        *log.Log      // custom logger
+
+	queries    []*cwMetricQuery // GetMetricData metric queries, if present GetMetricData will be used
+	expression string           // GetMetricData metric math expression over the metric queries
+
+	log *log.Log // custom logger
 }
 
 // cwMetricCreator creates the cloudwatch metric gatherer creator
@@ -130,65 +223,74 @@ func NewCWMetric(ctx context.Context, opts map[string]interface{}) (c *CWMetric,
 		}
 	}()
 
-	// type assertions of the dimesions conf
-	d := opts[cwDimensionsOpt]
-	lenD := len(d.([]interface{}))
-	td := make([]map[interface{}]interface{}, lenD)
-
-	for i, dd := range d.([]interface{}) {
-		td[i] = dd.(map[interface{}]interface{})
-	}
+	var ok bool
 
-	c.dimensions = make([]*dimension, lenD)
-	for i, k := range td {
-		n, ok := k[cwDimensionsNameOpt]
-		if !ok {
-			return nil, fmt.Errorf("%s configuration opt is invalid", cwDimensionsOpt)
+	if qs, ok := opts[cwMetricQueriesOpt]; ok {
+		// GetMetricData mode
+		for _, qI := range qs.([]interface{}) {
+			q, err := parseCwMetricQuery(qI.(map[interface{}]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			for _, cq := range c.queries {
+				if cq.id == q.id {
+					return nil, fmt.Errorf("%s configuration opt has repeated ids: %s", cwMetricQueriesOpt, q.id)
+				}
+			}
+			c.queries = append(c.queries, q)
 		}
-		v, ok := k[cwDimensionsValueOpt]
-		if !ok {
-			return nil, fmt.Errorf("%s configuration opt is invalid", cwDimensionsOpt)
+		if len(c.queries) == 0 {
+			return nil, fmt.Errorf("%s configuration opt is invalid", cwMetricQueriesOpt)
 		}
 
-		c.dimensions[i] = &dimension{n.(string), v.(string)}
-	}
-
-	var ok bool
+		if v, ok := opts[cwExpressionOpt]; ok {
+			c.expression = v.(string)
+		}
+		// Without expression the result is the only metric
+		if c.expression == "" && len(c.queries) != 1 {
+			return nil, fmt.Errorf("%s configuration opt is required with multiple %s", cwExpressionOpt, cwMetricQueriesOpt)
+		}
+	} else {
+		// GetMetricStatistics mode
+		if c.dimensions, err = parseCwDimensions(opts[cwDimensionsOpt]); err != nil {
+			return nil, err
+		}
 
-	// Check metric name
-	if c.metricName, ok = opts[cwMetricNameOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", cwMetricNameOpt)
-	}
+		// Check metric name
+		if c.metricName, ok = opts[cwMetricNameOpt].(string); !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", cwMetricNameOpt)
+		}
 
-	if c.metricName == "" {
-		return nil, fmt.Errorf("%s configuration opt is required", cwMetricNameOpt)
-	}
+		if c.metricName == "" {
+			return nil, fmt.Errorf("%s configuration opt is required", cwMetricNameOpt)
+		}
 
-	// Check namespace
-	if c.namespace, ok = opts[cwNamespaceOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", cwNamespaceOpt)
-	}
+		// Check namespace
+		if c.namespace, ok = opts[cwNamespaceOpt].(string); !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", cwNamespaceOpt)
+		}
 
-	if c.namespace == "" {
-		return nil, fmt.Errorf("%s configuration opt is required", cwNamespaceOpt)
-	}
+		if c.namespace == "" {
+			return nil, fmt.Errorf("%s configuration opt is required", cwNamespaceOpt)
+		}
 
-	// Check statistic
-	if c.statistic, ok = opts[cwStatisticOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", cwStatisticOpt)
-	}
+		// Check statistic
+		if c.statistic, ok = opts[cwStatisticOpt].(string); !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", cwStatisticOpt)
+		}
 
-	if err = checkCwStatistic(c.statistic); err != nil {
-		return nil, fmt.Errorf("%s configuration opt is invalid", cwStatisticOpt)
-	}
+		if err = checkCwStatistic(c.statistic); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is invalid", cwStatisticOpt)
+		}
 
-	// Check unit
-	if c.unit, ok = opts[cwUnitOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", cwUnitOpt)
-	}
+		// Check unit
+		if c.unit, ok = opts[cwUnitOpt].(string); !ok {
+			return nil, fmt.Errorf("%s configuration opt is required", cwUnitOpt)
+		}
 
-	if err = checkCwUnit(c.unit); err != nil {
-		return nil, fmt.Errorf("%s configuration opt is invalid", cwUnitOpt)
+		if err = checkCwUnit(c.unit); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is invalid", cwUnitOpt)
+		}
 	}
 
 	// Check region
@@ -239,7 +341,103 @@ func NewCWMetric(ctx context.Context, opts map[string]interface{}) (c *CWMetric,
 }
 
 // Gather gets the metrics from cloudwatch
-func (c *CWMetric) Gather(_ context.Context) (types.Quantity, error) {
+func (c *CWMetric) Gather(ctx context.Context) (types.Quantity, error) {
+	if len(c.queries) > 0 {
+		return c.gatherMetricData(ctx)
+	}
+	return c.gatherMetricStatistics(ctx)
+}
+
+// gatherMetricData gets the metric using GetMetricData, the result will be the
+// expression result or the only metric query if there is no expression
+func (c *CWMetric) gatherMetricData(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	resultID := cwExpressionID
+	if c.expression == "" {
+		resultID = c.queries[0].id
+	}
+
+	mqs := []*cloudwatch.MetricDataQuery{}
+	for _, cq := range c.queries {
+		ds := make([]*cloudwatch.Dimension, len(cq.dimensions))
+		for i, d := range cq.dimensions {
+			ds[i] = &cloudwatch.Dimension{
+				Name:  aws.String(d.name),
+				Value: aws.String(d.value),
+			}
+		}
+
+		ms := &cloudwatch.MetricStat{
+			Metric: &cloudwatch.Metric{
+				MetricName: aws.String(cq.metricName),
+				Namespace:  aws.String(cq.namespace),
+				Dimensions: ds,
+			},
+			Period: aws.Int64(60),
+			Stat:   aws.String(cq.statistic),
+		}
+		if cq.unit != "" {
+			ms.Unit = aws.String(cq.unit)
+		}
+
+		mqs = append(mqs, &cloudwatch.MetricDataQuery{
+			Id:         aws.String(cq.id),
+			MetricStat: ms,
+			ReturnData: aws.Bool(cq.id == resultID),
+		})
+	}
+
+	if c.expression != "" {
+		mqs = append(mqs, &cloudwatch.MetricDataQuery{
+			Id:         aws.String(cwExpressionID),
+			Expression: aws.String(c.expression),
+			ReturnData: aws.Bool(true),
+		})
+	}
+
+	// Get the latest aggregated metric (one minute)
+	c.log.Debugf("Retrieving cloudwatch metric data")
+	end := time.Now().UTC().Add(c.offset) // apply offset
+	start := end.Add(-1 * time.Minute)
+	params := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(start),
+		EndTime:           aws.Time(end),
+		MetricDataQueries: mqs,
+		ScanBy:            aws.String(cloudwatch.ScanByTimestampDescending),
+	}
+	resp, err := c.client.GetMetricDataWithContext(ctx, params)
+	if err != nil {
+		return q, err
+	}
+
+	var res *cloudwatch.MetricDataResult
+	for _, r := range resp.MetricDataResults {
+		if aws.StringValue(r.Id) == resultID {
+			res = r
+			break
+		}
+	}
+	if res == nil {
+		return q, fmt.Errorf("missing %s result on metric data", resultID)
+	}
+	if aws.StringValue(res.StatusCode) == cloudwatch.StatusCodeInternalError {
+		return q, fmt.Errorf("cloudwatch failed calculating the metric data")
+	}
+
+	// Take the latest value
+	if len(res.Values) == 0 {
+		return q, fmt.Errorf("Wrong value of metrics retrieved: %d", len(res.Values))
+	}
+	q.Q = utilmath.RoundInt64(aws.Float64Value(res.Values[0]))
+
+	c.log.Debugf("Retrieved cloudwatch metric data input: %s", q)
+
+	return q, nil
+}
+
+// gatherMetricStatistics gets the metric using GetMetricStatistics
+func (c *CWMetric) gatherMetricStatistics(_ context.Context) (types.Quantity, error) {
 	q := types.Quantity{}
 
 	ds := make([]*cloudwatch.Dimension, len(c.dimensions))
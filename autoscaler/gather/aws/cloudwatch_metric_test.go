@@ -289,3 +289,204 @@ func TestCWMetricGatherMultipleMessages(t *testing.T) {
 		}
 	}
 }
+
+func TestCWMetricDataCreation(t *testing.T) {
+	m1 := map[interface{}]interface{}{
+		cwQueryIDOpt:    "m1",
+		cwMetricNameOpt: "ApproximateNumberOfMessagesVisible",
+		cwNamespaceOpt:  "AWS/SQS",
+		cwStatisticOpt:  "Maximum",
+		cwDimensionsOpt: []interface{}{
+			map[interface{}]interface{}{cwDimensionsNameOpt: "QueueName", cwDimensionsValueOpt: "jobs"},
+		},
+	}
+	m2 := map[interface{}]interface{}{
+		cwQueryIDOpt:    "m2",
+		cwMetricNameOpt: "ApproximateNumberOfMessagesVisible",
+		cwNamespaceOpt:  "AWS/SQS",
+		cwStatisticOpt:  "Maximum",
+		cwUnitOpt:       "Count",
+	}
+
+	tests := []struct {
+		queries    []interface{}
+		expression string
+
+		wantQueries int
+		wantError   bool
+	}{
+		{[]interface{}{m1}, "", 1, false},
+		{[]interface{}{m1, m2}, "m1 + m2", 2, false},
+		{[]interface{}{m1}, "m1 / 2", 1, false},
+
+		// Multiple queries require an expression
+		{[]interface{}{m1, m2}, "", 0, true},
+		// Repeated ids
+		{[]interface{}{m1, m1}, "m1 + m1", 0, true},
+		// No queries
+		{[]interface{}{}, "", 0, true},
+		// Wrong types
+		{[]interface{}{1}, "", 0, true},
+		// Wrong ids
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "M1", cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "1m", cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: cwExpressionID, cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average"}}, "", 0, true},
+		// Missing params
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "m1", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "m1", cwMetricNameOpt: "CPUUtilization", cwStatisticOpt: "Average"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "m1", cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2"}}, "", 0, true},
+		// Wrong params
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "m1", cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Max"}}, "", 0, true},
+		{[]interface{}{map[interface{}]interface{}{cwQueryIDOpt: "m1", cwMetricNameOpt: "CPUUtilization", cwNamespaceOpt: "AWS/EC2", cwStatisticOpt: "Average", cwUnitOpt: "percent"}}, "", 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			cwAwsRegionOpt:     "us-west-2",
+			cwMetricQueriesOpt: test.queries,
+			cwTimeOffsetOpt:    "-1m",
+		}
+		if test.expression != "" {
+			opts[cwExpressionOpt] = test.expression
+		}
+
+		c, err := NewCWMetric(context.TODO(), opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(c.queries) != test.wantQueries {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object length, want: %v; got %v", test, test.wantQueries, len(c.queries))
+		}
+
+		if c.expression != test.expression {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.expression, c.expression)
+		}
+	}
+}
+
+func TestCWMetricDataGather(t *testing.T) {
+	m1 := map[interface{}]interface{}{
+		cwQueryIDOpt:    "m1",
+		cwMetricNameOpt: "ApproximateNumberOfMessagesVisible",
+		cwNamespaceOpt:  "AWS/SQS",
+		cwStatisticOpt:  "Maximum",
+		cwUnitOpt:       "Count",
+		cwDimensionsOpt: []interface{}{
+			map[interface{}]interface{}{cwDimensionsNameOpt: "QueueName", cwDimensionsValueOpt: "jobs"},
+		},
+	}
+	m2 := map[interface{}]interface{}{
+		cwQueryIDOpt:    "m2",
+		cwMetricNameOpt: "ApproximateNumberOfMessagesNotVisible",
+		cwNamespaceOpt:  "AWS/SQS",
+		cwStatisticOpt:  "Maximum",
+	}
+
+	tests := []struct {
+		queries    []interface{}
+		expression string
+		resultID   string
+		values     []float64
+
+		wantMetric int64
+		wantError  bool
+	}{
+		{[]interface{}{m1}, "", "m1", []float64{1000}, 1000, false},
+		{[]interface{}{m1}, "", "m1", []float64{9.45, 20}, 9, false},
+		{[]interface{}{m1, m2}, "m1 + m2", cwExpressionID, []float64{48.9}, 49, false},
+		{[]interface{}{m1, m2}, "m1 / m2", cwExpressionID, []float64{0.8}, 1, false},
+		{[]interface{}{m1, m2}, "m1 / m2", cwExpressionID, []float64{1.99}, 2, false},
+		{[]interface{}{m1, m2}, "m1 / m2", cwExpressionID, []float64{0.3}, 0, false},
+		{[]interface{}{m1}, "m1 * 2", cwExpressionID, []float64{200, 100, 50}, 200, false},
+
+		// No values
+		{[]interface{}{m1}, "", "m1", []float64{}, 0, true},
+		{[]interface{}{m1, m2}, "m1 + m2", cwExpressionID, []float64{}, 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			cwAwsRegionOpt:     "us-west-2",
+			cwMetricQueriesOpt: test.queries,
+			cwTimeOffsetOpt:    "0s",
+		}
+		if test.expression != "" {
+			opts[cwExpressionOpt] = test.expression
+		}
+
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCWMetric := sdk.NewMockCloudWatchAPI(ctrl)
+		c, err := NewCWMetric(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		c.client = mockCWMetric
+
+		// Set our mock desired result
+		awsMock.MockGetMetricData(t, mockCWMetric, test.resultID, test.values)
+
+		res, err := c.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gathering should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gathering shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if res.Q != test.wantMetric {
+			t.Errorf("\n- %+v\n  Gathered quantity doesn't look good, got: %d want: %d", test, res.Q, test.wantMetric)
+		}
+	}
+}
+
+func TestCWMetricDataGatherWrong(t *testing.T) {
+	opts := map[string]interface{}{
+		cwAwsRegionOpt: "us-west-2",
+		cwMetricQueriesOpt: []interface{}{
+			map[interface{}]interface{}{
+				cwQueryIDOpt:    "m1",
+				cwMetricNameOpt: "CPUUtilization",
+				cwNamespaceOpt:  "AWS/EC2",
+				cwStatisticOpt:  "Average",
+			},
+		},
+		cwTimeOffsetOpt: "0s",
+	}
+
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCWMetric := sdk.NewMockCloudWatchAPI(ctrl)
+	c, err := NewCWMetric(context.TODO(), opts)
+	if err != nil {
+		t.Fatalf("\n-  Creation shouldn't give error: %v", err)
+	}
+	c.client = mockCWMetric
+
+	// Set our mock desired result
+	awsMock.MockGetMetricDataError(t, mockCWMetric)
+
+	_, err = c.Gather(context.TODO())
+	if err == nil {
+		t.Errorf("\n-  Gathering should give error, it didn't")
+	}
+}
@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	ecsCRAwsRegionOpt      = "aws_region"
+	ecsCRClusterNameOpt    = "cluster_name"
+	ecsCRMetricOpt         = "metric"
+	ecsCRTaskDefinitionOpt = "task_definition"
+
+	// Metrics
+	ecsCRCPUReservationMetric    = "cpu_reservation"
+	ecsCRMemoryReservationMetric = "memory_reservation"
+	ecsCRTaskFitMetric           = "task_fit"
+
+	// ECS container instance resources
+	ecsCRCPUResource    = "CPU"
+	ecsCRMemoryResource = "MEMORY"
+
+	// Max container instances per describe call
+	ecsCRDescribeBatch = 100
+
+	// the name
+	ecsCRRegName = "aws_ecs_cluster_reservation"
+)
+
+// ecsResources are the resources of a container instance or task
+type ecsResources struct {
+	cpu    int64
+	memory int64
+}
+
+// ECSClusterReservation represents an object for gathering the reservation of an ECS cluster
+type ECSClusterReservation struct {
+	session *session.Session
+	client  ecsiface.ECSAPI
+
+	clusterName    string // The ECS cluster name
+	metric         string // The metric to gather (cpu_reservation, memory_reservation, task_fit)
+	taskDefinition string // The task definition used to check how many tasks fit on the cluster
+
+	log *log.Log // Custom logger
+}
+
+// ecsClusterReservationCreator creates the ECS cluster reservation gatherer creator
+type ecsClusterReservationCreator struct{}
+
+func (e *ecsClusterReservationCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewECSClusterReservation(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(ecsCRRegName, &ecsClusterReservationCreator{})
+}
+
+// NewECSClusterReservation creates an ECS cluster reservation gatherer
+func NewECSClusterReservation(ctx context.Context, opts map[string]interface{}) (e *ECSClusterReservation, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	e = &ECSClusterReservation{}
+
+	var ok bool
+
+	if e.clusterName, ok = opts[ecsCRClusterNameOpt].(string); !ok || e.clusterName == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ecsCRClusterNameOpt)
+	}
+
+	if e.metric, ok = opts[ecsCRMetricOpt].(string); !ok || e.metric == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ecsCRMetricOpt)
+	}
+
+	switch e.metric {
+	case ecsCRCPUReservationMetric, ecsCRMemoryReservationMetric:
+	case ecsCRTaskFitMetric:
+		if e.taskDefinition, ok = opts[ecsCRTaskDefinitionOpt].(string); !ok || e.taskDefinition == "" {
+			return nil, fmt.Errorf("%s configuration opt is required on %s metric", ecsCRTaskDefinitionOpt, ecsCRTaskFitMetric)
+		}
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", ecsCRMetricOpt)
+	}
+
+	region, ok := opts[ecsCRAwsRegionOpt].(string)
+	if !ok || region == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ecsCRAwsRegionOpt)
+	}
+
+	// Create AWS session
+	ss := session.New(&aws.Config{Region: aws.String(region)})
+	if ss == nil {
+		return nil, fmt.Errorf("error creating aws session")
+	}
+
+	// Create AWS ECS service client
+	e.session = ss
+	e.client = ecs.New(ss)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	e.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       ecsCRRegName,
+	})
+
+	return
+}
+
+// ecsResourcesFrom returns the CPU and memory resources of an ECS resource list
+func ecsResourcesFrom(rs []*ecs.Resource) ecsResources {
+	res := ecsResources{}
+	for _, r := range rs {
+		switch aws.StringValue(r.Name) {
+		case ecsCRCPUResource:
+			res.cpu = aws.Int64Value(r.IntegerValue)
+		case ecsCRMemoryResource:
+			res.memory = aws.Int64Value(r.IntegerValue)
+		}
+	}
+	return res
+}
+
+// containerInstances returns the registered and remaining resources of the active
+// container instances of the cluster
+func (e *ECSClusterReservation) containerInstances(ctx context.Context) (registered, remaining []ecsResources, err error) {
+	// Get all the active container instances
+	arns := []*string{}
+	params := &ecs.ListContainerInstancesInput{
+		Cluster: aws.String(e.clusterName),
+		Status:  aws.String(ecs.ContainerInstanceStatusActive),
+	}
+	for {
+		resp, err := e.client.ListContainerInstancesWithContext(ctx, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		arns = append(arns, resp.ContainerInstanceArns...)
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		params.NextToken = resp.NextToken
+	}
+
+	// Describe them in batches
+	for i := 0; i < len(arns); i += ecsCRDescribeBatch {
+		end := i + ecsCRDescribeBatch
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := e.client.DescribeContainerInstancesWithContext(ctx, &ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(e.clusterName),
+			ContainerInstances: arns[i:end],
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(resp.Failures) > 0 {
+			return nil, nil, fmt.Errorf("error describing container instances: %s", aws.StringValue(resp.Failures[0].Reason))
+		}
+
+		for _, ci := range resp.ContainerInstances {
+			registered = append(registered, ecsResourcesFrom(ci.RegisteredResources))
+			remaining = append(remaining, ecsResourcesFrom(ci.RemainingResources))
+		}
+	}
+
+	if len(registered) == 0 {
+		return nil, nil, fmt.Errorf("%s cluster doesn't have active container instances", e.clusterName)
+	}
+
+	return registered, remaining, nil
+}
+
+// taskResources returns the resources that a task of the task definition reserves
+func (e *ECSClusterReservation) taskResources(ctx context.Context) (ecsResources, error) {
+	res := ecsResources{}
+	resp, err := e.client.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(e.taskDefinition),
+	})
+	if err != nil {
+		return res, err
+	}
+	td := resp.TaskDefinition
+	if td == nil {
+		return res, fmt.Errorf("missing %s task definition", e.taskDefinition)
+	}
+
+	// Container level reservations, memory reservation is used if there isn't hard limit
+	for _, c := range td.ContainerDefinitions {
+		res.cpu += aws.Int64Value(c.Cpu)
+		if m := aws.Int64Value(c.Memory); m > 0 {
+			res.memory += m
+		} else {
+			res.memory += aws.Int64Value(c.MemoryReservation)
+		}
+	}
+
+	// Task level reservations have precedence
+	if v, err := strconv.ParseInt(aws.StringValue(td.Cpu), 10, 64); err == nil {
+		res.cpu = v
+	}
+	if v, err := strconv.ParseInt(aws.StringValue(td.Memory), 10, 64); err == nil {
+		res.memory = v
+	}
+
+	if res.memory <= 0 {
+		return res, fmt.Errorf("%s task definition doesn't reserve memory", e.taskDefinition)
+	}
+
+	return res, nil
+}
+
+// Gather gets the reservation of the ECS cluster
+func (e *ECSClusterReservation) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	e.log.Debugf("Gathering %s of %s cluster", e.metric, e.clusterName)
+	registered, remaining, err := e.containerInstances(ctx)
+	if err != nil {
+		return q, err
+	}
+
+	switch e.metric {
+	case ecsCRCPUReservationMetric, ecsCRMemoryReservationMetric:
+		var total, free int64
+		for i := range registered {
+			if e.metric == ecsCRCPUReservationMetric {
+				total += registered[i].cpu
+				free += remaining[i].cpu
+			} else {
+				total += registered[i].memory
+				free += remaining[i].memory
+			}
+		}
+		if total == 0 {
+			return q, fmt.Errorf("%s cluster doesn't have registered resources", e.clusterName)
+		}
+		// Percent of the reserved resources
+		q.Q = utilmath.RoundInt64(float64(total-free) * 100 / float64(total))
+	case ecsCRTaskFitMetric:
+		task, err := e.taskResources(ctx)
+		if err != nil {
+			return q, err
+		}
+		// A task can't be split between instances
+		for _, r := range remaining {
+			fit := r.memory / task.memory
+			if task.cpu > 0 && r.cpu/task.cpu < fit {
+				fit = r.cpu / task.cpu
+			}
+			q.Q += fit
+		}
+	default:
+		return q, fmt.Errorf("invalid metric: %s", e.metric)
+	}
+
+	e.log.Debugf("Retrieved ECS cluster reservation input: %s", q)
+
+	return q, nil
+}
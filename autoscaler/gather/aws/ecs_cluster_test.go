@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/themotion/ladder/mock/aws"
+	"github.com/themotion/ladder/mock/aws/sdk"
+)
+
+func TestECSClusterReservationCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantError bool
+	}{
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:   "us-west-2",
+				ecsCRClusterNameOpt: "cluster",
+				ecsCRMetricOpt:      "cpu_reservation",
+			},
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:   "eu-west-1",
+				ecsCRClusterNameOpt: "cluster",
+				ecsCRMetricOpt:      "memory_reservation",
+			},
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:      "eu-west-1",
+				ecsCRClusterNameOpt:    "cluster",
+				ecsCRMetricOpt:         "task_fit",
+				ecsCRTaskDefinitionOpt: "render:12",
+			},
+		},
+		// Missing params
+		{
+			opts: map[string]interface{}{
+				ecsCRClusterNameOpt: "cluster",
+				ecsCRMetricOpt:      "cpu_reservation",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt: "us-west-2",
+				ecsCRMetricOpt:    "cpu_reservation",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:   "us-west-2",
+				ecsCRClusterNameOpt: "cluster",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:   "us-west-2",
+				ecsCRClusterNameOpt: "cluster",
+				ecsCRMetricOpt:      "task_fit",
+			},
+			wantError: true,
+		},
+		// Wrong params
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:   "us-west-2",
+				ecsCRClusterNameOpt: "cluster",
+				ecsCRMetricOpt:      "CPUReservation",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				ecsCRAwsRegionOpt:      "us-west-2",
+				ecsCRClusterNameOpt:    "cluster",
+				ecsCRMetricOpt:         "task_fit",
+				ecsCRTaskDefinitionOpt: 12,
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		e, err := NewECSClusterReservation(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if aws.StringValue(e.session.Config.Region) != test.opts[ecsCRAwsRegionOpt] {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.opts[ecsCRAwsRegionOpt], aws.StringValue(e.session.Config.Region))
+		}
+	}
+}
+
+func TestECSClusterReservationGather(t *testing.T) {
+	tests := []struct {
+		metric    string
+		instances []awsMock.ECSInstanceResources
+		pageSize  int
+		cpus      []int64
+		memories  []int64
+		soft      bool
+
+		wantQ     int64
+		wantError bool
+	}{
+		{
+			metric: "cpu_reservation",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 512, RemainingMemory: 1000},
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 0, RemainingMemory: 500},
+			},
+			wantQ: 75,
+		},
+		{
+			metric: "memory_reservation",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 512, RemainingMemory: 1000},
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 0, RemainingMemory: 500},
+			},
+			wantQ: 63,
+		},
+		// Empty cluster
+		{
+			metric: "cpu_reservation",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 2048, RegisteredMemory: 4000, RemainingCPU: 2048, RemainingMemory: 4000},
+			},
+			wantQ: 0,
+		},
+		// Multiple pages and describe batches
+		{
+			metric: "memory_reservation",
+			instances: func() []awsMock.ECSInstanceResources {
+				res := make([]awsMock.ECSInstanceResources, 250)
+				for i := range res {
+					res[i] = awsMock.ECSInstanceResources{RegisteredCPU: 1024, RegisteredMemory: 1000, RemainingCPU: 1024, RemainingMemory: 600}
+				}
+				return res
+			}(),
+			pageSize: 30,
+			wantQ:    40,
+		},
+		// Fit limited by memory
+		{
+			metric: "task_fit",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 1024, RemainingMemory: 1000},
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 1024, RemainingMemory: 499},
+			},
+			cpus:     []int64{128, 0},
+			memories: []int64{200, 50},
+			wantQ:    5,
+		},
+		// Fit limited by cpu
+		{
+			metric: "task_fit",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 600, RemainingMemory: 2000},
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 300, RemainingMemory: 2000},
+			},
+			cpus:     []int64{256},
+			memories: []int64{100},
+			soft:     true,
+			wantQ:    3,
+		},
+		// Tasks without memory
+		{
+			metric: "task_fit",
+			instances: []awsMock.ECSInstanceResources{
+				{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 1024, RemainingMemory: 2000},
+			},
+			cpus:      []int64{256},
+			memories:  []int64{0},
+			wantError: true,
+		},
+		// Cluster without instances
+		{
+			metric:    "cpu_reservation",
+			instances: []awsMock.ECSInstanceResources{},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockECS := sdk.NewMockECSAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockECSContainerInstances(t, mockECS, "cluster", test.instances, test.pageSize, false)
+		awsMock.MockECSDescribeTaskDefinition(t, mockECS, "render:12", test.cpus, test.memories, test.soft, false)
+
+		e, err := NewECSClusterReservation(context.TODO(), map[string]interface{}{
+			ecsCRAwsRegionOpt:      "us-west-2",
+			ecsCRClusterNameOpt:    "cluster",
+			ecsCRMetricOpt:         test.metric,
+			ecsCRTaskDefinitionOpt: "render:12",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		e.client = mockECS
+
+		res, err := e.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gathering should give error, it didn't: %+v", test, res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gathering shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if res.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Gathered quantity doesn't look good, got: %d want: %d", test, res.Q, test.wantQ)
+		}
+	}
+}
+
+func TestECSClusterReservationGatherError(t *testing.T) {
+	instances := []awsMock.ECSInstanceResources{
+		{RegisteredCPU: 1024, RegisteredMemory: 2000, RemainingCPU: 1024, RemainingMemory: 2000},
+	}
+
+	tests := []struct {
+		metric       string
+		listError    bool
+		taskDefError bool
+	}{
+		{"cpu_reservation", true, false},
+		{"task_fit", true, false},
+		{"task_fit", false, true},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockECS := sdk.NewMockECSAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockECSContainerInstances(t, mockECS, "cluster", instances, 0, test.listError)
+		awsMock.MockECSDescribeTaskDefinition(t, mockECS, "render:12", []int64{128}, []int64{128}, false, test.taskDefError)
+
+		e, err := NewECSClusterReservation(context.TODO(), map[string]interface{}{
+			ecsCRAwsRegionOpt:      "us-west-2",
+			ecsCRClusterNameOpt:    "cluster",
+			ecsCRMetricOpt:         test.metric,
+			ecsCRTaskDefinitionOpt: "render:12",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		e.client = mockECS
+
+		_, err = e.Gather(context.TODO())
+		if err == nil {
+			t.Errorf("\n- %+v\n  Gathering should give error, it didn't", test)
+		}
+	}
+}
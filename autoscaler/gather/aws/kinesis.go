@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	kinAwsRegionOpt  = "aws_region"
+	kinStreamNameOpt = "stream_name"
+	kinMetricOpt     = "metric"
+	kinWindowOpt     = "window"
+
+	// Metrics
+	kinIteratorAgeMetric     = "iterator_age"
+	kinIncomingRecordsMetric = "incoming_records"
+	kinOpenShardsMetric      = "open_shards"
+
+	// Cloudwatch metrics
+	kinCWNamespace             = "AWS/Kinesis"
+	kinCWStreamNameDimension   = "StreamName"
+	kinCWIteratorAgeMetric     = "GetRecords.IteratorAgeMilliseconds"
+	kinCWIncomingRecordsMetric = "IncomingRecords"
+	kinCWPeriod                = 60 // seconds
+
+	// Defaults
+	kinDefaultWindow = 5 * time.Minute
+
+	// the name
+	kinRegName = "aws_kinesis"
+)
+
+// Generate kinesis AWS API mocks running go generate
+//go:generate mockgen -source ../../../vendor/github.com/aws/aws-sdk-go/service/kinesis/kinesisiface/interface.go -package sdk -destination ../../../mock/aws/sdk/kinesisiface_mock.go
+
+// Kinesis represents an object for gathering inputs from a Kinesis stream
+type Kinesis struct {
+	session  *session.Session
+	client   kinesisiface.KinesisAPI
+	cwClient cloudwatchiface.CloudWatchAPI
+
+	streamName string        // The Kinesis stream name
+	metric     string        // The metric to gather (iterator_age, incoming_records, open_shards)
+	window     time.Duration // The window where the latest cloudwatch datapoint will be searched
+
+	now func() time.Time // Clock, used to get the current time
+	log *log.Log         // Custom logger
+}
+
+// kinesisCreator creates the kinesis gatherer creator
+type kinesisCreator struct{}
+
+func (k *kinesisCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewKinesis(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(kinRegName, &kinesisCreator{})
+}
+
+// NewKinesis creates a Kinesis gatherer
+func NewKinesis(ctx context.Context, opts map[string]interface{}) (k *Kinesis, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	k = &Kinesis{
+		window: kinDefaultWindow,
+		now:    time.Now,
+	}
+
+	var ok bool
+
+	if k.streamName, ok = opts[kinStreamNameOpt].(string); !ok || k.streamName == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", kinStreamNameOpt)
+	}
+
+	if k.metric, ok = opts[kinMetricOpt].(string); !ok || k.metric == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", kinMetricOpt)
+	}
+
+	switch k.metric {
+	case kinIteratorAgeMetric, kinIncomingRecordsMetric, kinOpenShardsMetric:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", kinMetricOpt)
+	}
+
+	if v, ok := opts[kinWindowOpt]; ok {
+		if k.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", kinWindowOpt, err)
+		}
+	}
+	// Cloudwatch kinesis metrics are aggregated by minute
+	if k.window < time.Minute {
+		return nil, fmt.Errorf("%s should be one minute or greater", kinWindowOpt)
+	}
+
+	region, ok := opts[kinAwsRegionOpt].(string)
+	if !ok || region == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", kinAwsRegionOpt)
+	}
+
+	// Create AWS session
+	ss := session.New(&aws.Config{Region: aws.String(region)})
+	if ss == nil {
+		return nil, fmt.Errorf("error creating aws session")
+	}
+
+	// Create AWS kinesis and cloudwatch service clients
+	k.session = ss
+	k.client = kinesis.New(ss)
+	k.cwClient = cloudwatch.New(ss)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	k.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       kinRegName,
+	})
+
+	return
+}
+
+// latestMetric returns the latest available datapoint of the stream cloudwatch metric on the
+// window ending at end, this way we don't need to guess when cloudwatch has the metric ready
+func (k *Kinesis) latestMetric(ctx context.Context, metricName, statistic string, end time.Time) (float64, error) {
+	params := &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(end.Add(-k.window)),
+		EndTime:   aws.Time(end),
+		ScanBy:    aws.String(cloudwatch.ScanByTimestampDescending),
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String(kinCWNamespace),
+						MetricName: aws.String(metricName),
+						Dimensions: []*cloudwatch.Dimension{
+							{
+								Name:  aws.String(kinCWStreamNameDimension),
+								Value: aws.String(k.streamName),
+							},
+						},
+					},
+					Period: aws.Int64(kinCWPeriod),
+					Stat:   aws.String(statistic),
+				},
+				ReturnData: aws.Bool(true),
+			},
+		},
+	}
+
+	resp, err := k.cwClient.GetMetricDataWithContext(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(resp.MetricDataResults) != 1 {
+		return 0, fmt.Errorf("wrong number of metric data results: %d", len(resp.MetricDataResults))
+	}
+	res := resp.MetricDataResults[0]
+	if len(res.Values) == 0 {
+		return 0, fmt.Errorf("there aren't %s datapoints on the last %s", metricName, k.window)
+	}
+
+	return aws.Float64Value(res.Values[0]), nil
+}
+
+// openShards returns the number of open shards of the stream
+func (k *Kinesis) openShards(ctx context.Context) (int64, error) {
+	resp, err := k.client.DescribeStreamSummaryWithContext(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(k.streamName),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.StreamDescriptionSummary == nil {
+		return 0, fmt.Errorf("missing %s stream description", k.streamName)
+	}
+	return aws.Int64Value(resp.StreamDescriptionSummary.OpenShardCount), nil
+}
+
+// Gather gets the metric of the Kinesis stream
+func (k *Kinesis) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	k.log.Debugf("Gathering %s of %s stream", k.metric, k.streamName)
+	switch k.metric {
+	case kinIteratorAgeMetric:
+		// Milliseconds of the most delayed consumer
+		v, err := k.latestMetric(ctx, kinCWIteratorAgeMetric, cloudwatch.StatisticMaximum, k.now().UTC())
+		if err != nil {
+			return q, err
+		}
+		q.Q = utilmath.RoundInt64(v)
+	case kinIncomingRecordsMetric:
+		// Records per second, only of complete periods, the sum of the period in progress
+		// is partial and would give a lower rate
+		end := k.now().UTC().Truncate(kinCWPeriod * time.Second)
+		v, err := k.latestMetric(ctx, kinCWIncomingRecordsMetric, cloudwatch.StatisticSum, end)
+		if err != nil {
+			return q, err
+		}
+		q.Q = utilmath.RoundInt64(v / kinCWPeriod)
+	case kinOpenShardsMetric:
+		v, err := k.openShards(ctx)
+		if err != nil {
+			return q, err
+		}
+		q.Q = v
+	default:
+		return q, fmt.Errorf("invalid metric: %s", k.metric)
+	}
+
+	k.log.Debugf("Retrieved kinesis input: %s", q)
+
+	return q, nil
+}
@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/themotion/ladder/mock/aws"
+	"github.com/themotion/ladder/mock/aws/sdk"
+)
+
+func TestKinesisCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantWindow time.Duration
+		wantError  bool
+	}{
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "iterator_age",
+			},
+			wantWindow: kinDefaultWindow,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "eu-west-1",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "incoming_records",
+				kinWindowOpt:     "10m",
+			},
+			wantWindow: 10 * time.Minute,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "eu-west-1",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "open_shards",
+			},
+			wantWindow: kinDefaultWindow,
+		},
+		// Missing params
+		{
+			opts: map[string]interface{}{
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "iterator_age",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt: "us-west-2",
+				kinMetricOpt:    "iterator_age",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: "events",
+			},
+			wantError: true,
+		},
+		// Wrong params
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "IteratorAgeMilliseconds",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "iterator_age",
+				kinWindowOpt:     "30s",
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: "events",
+				kinMetricOpt:     "iterator_age",
+				kinWindowOpt:     "5g",
+			},
+			wantError: true,
+		},
+		// Wrong types
+		{
+			opts: map[string]interface{}{
+				kinAwsRegionOpt:  "us-west-2",
+				kinStreamNameOpt: 1,
+				kinMetricOpt:     "iterator_age",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		k, err := NewKinesis(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if aws.StringValue(k.session.Config.Region) != test.opts[kinAwsRegionOpt] {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.opts[kinAwsRegionOpt], aws.StringValue(k.session.Config.Region))
+		}
+
+		if k.window != test.wantWindow {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantWindow, k.window)
+		}
+	}
+}
+
+func TestKinesisGather(t *testing.T) {
+	tests := []struct {
+		metric     string
+		values     []float64
+		openShards int64
+
+		wantQ     int64
+		wantError bool
+	}{
+		{"iterator_age", []float64{35000, 12000}, 0, 35000, false},
+		{"iterator_age", []float64{0}, 0, 0, false},
+		{"incoming_records", []float64{6000, 120}, 0, 100, false},
+		{"incoming_records", []float64{100}, 0, 2, false},
+		{"open_shards", nil, 8, 8, false},
+
+		// Without datapoints
+		{"iterator_age", []float64{}, 0, 0, true},
+		{"incoming_records", []float64{}, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCW := sdk.NewMockCloudWatchAPI(ctrl)
+		mockKinesis := sdk.NewMockKinesisAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockGetMetricData(t, mockCW, "m", test.values)
+		awsMock.MockDescribeStreamSummary(t, mockKinesis, "events", test.openShards)
+
+		k, err := NewKinesis(context.TODO(), map[string]interface{}{
+			kinAwsRegionOpt:  "us-west-2",
+			kinStreamNameOpt: "events",
+			kinMetricOpt:     test.metric,
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		k.cwClient = mockCW
+		k.client = mockKinesis
+
+		res, err := k.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gathering should give error, it didn't: %+v", test, res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gathering shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if res.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Gathered quantity doesn't look good, got: %d want: %d", test, res.Q, test.wantQ)
+		}
+	}
+}
+
+func TestKinesisGatherError(t *testing.T) {
+	for _, metric := range []string{"iterator_age", "incoming_records", "open_shards"} {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCW := sdk.NewMockCloudWatchAPI(ctrl)
+		mockKinesis := sdk.NewMockKinesisAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockGetMetricDataError(t, mockCW)
+		awsMock.MockDescribeStreamSummaryError(t, mockKinesis)
+
+		k, err := NewKinesis(context.TODO(), map[string]interface{}{
+			kinAwsRegionOpt:  "us-west-2",
+			kinStreamNameOpt: "events",
+			kinMetricOpt:     metric,
+		})
+		if err != nil {
+			t.Fatalf("\n- %s\n  Creation shouldn't give error: %v", metric, err)
+		}
+		k.cwClient = mockCW
+		k.client = mockKinesis
+
+		_, err = k.Gather(context.TODO())
+		if err == nil {
+			t.Errorf("\n- %s\n  Gathering should give error, it didn't", metric)
+		}
+	}
+}
+
+func TestKinesisGatherCompletePeriods(t *testing.T) {
+	now := time.Date(2017, 6, 12, 10, 34, 45, 0, time.UTC)
+	tests := []struct {
+		metric string
+
+		wantEnd time.Time
+	}{
+		// The incoming records of the minute in progress are partial
+		{"incoming_records", time.Date(2017, 6, 12, 10, 34, 0, 0, time.UTC)},
+		{"iterator_age", now},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCW := sdk.NewMockCloudWatchAPI(ctrl)
+
+		var gotInput *cloudwatch.GetMetricDataInput
+		mockCW.EXPECT().GetMetricDataWithContext(gomock.Any(), gomock.Any()).Do(func(ctx interface{}, input interface{}) {
+			gotInput = input.(*cloudwatch.GetMetricDataInput)
+		}).Return(&cloudwatch.GetMetricDataOutput{
+			MetricDataResults: []*cloudwatch.MetricDataResult{
+				{Id: aws.String("m"), Values: aws.Float64Slice([]float64{600})},
+			},
+		}, nil)
+
+		k, err := NewKinesis(context.TODO(), map[string]interface{}{
+			kinAwsRegionOpt:  "us-west-2",
+			kinStreamNameOpt: "events",
+			kinMetricOpt:     test.metric,
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		k.cwClient = mockCW
+		k.now = func() time.Time { return now }
+
+		if _, err := k.Gather(context.TODO()); err != nil {
+			t.Fatalf("\n- %+v\n  Gathering shouldn't give error: %v", test, err)
+		}
+
+		if !aws.TimeValue(gotInput.EndTime).Equal(test.wantEnd) {
+			t.Errorf("\n- %+v\n  Wrong query end, want: %s; got %s", test, test.wantEnd, aws.TimeValue(gotInput.EndTime))
+		}
+		if wantStart := test.wantEnd.Add(-k.window); !aws.TimeValue(gotInput.StartTime).Equal(wantStart) {
+			t.Errorf("\n- %+v\n  Wrong query start, want: %s; got %s", test, wantStart, aws.TimeValue(gotInput.StartTime))
+		}
+	}
+}
@@ -2,9 +2,12 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,15 +22,28 @@ import (
 
 const (
 	// Opts
-	awsRegionOpt     = "aws_region"
-	queueURLOpt      = "queue_url"
-	queuePropertyOpt = "queue_property"
+	awsRegionOpt       = "aws_region"
+	queueURLOpt        = "queue_url"
+	queueURLsOpt       = "queue_urls"
+	queueNamePrefixOpt = "queue_name_prefix"
+	queuePropertyOpt   = "queue_property"
+	queuePropertiesOpt = "queue_properties"
+	deadLetterOpt      = "dead_letter"
+
+	// Dead letter queue modes
+	sqsDeadLetterInclude = "include"
+	sqsDeadLetterExclude = "exclude"
+	sqsDeadLetterOnly    = "only"
 
 	// the name
 	sqsRegName = "aws_sqs"
 
 	// Number of calls for messages per gather
 	sqsCallTimes = 3
+
+	// Maximum number of queues returned when listing the queues, the API doesn't
+	// paginate them
+	sqsListQueuesMax = 1000
 )
 
 // Generate sqs AWS API mocks running go generate
@@ -44,9 +60,19 @@ type SQS struct {
 	// The queue property where we will get the information
 	QueueProperty string
 
+	queueURLs       []string // The queues that will be aggregated
+	queueNamePrefix string   // The prefix of the queues that will be aggregated
+	properties      []string // The queue properties that will be summed
+	deadLetter      string   // How to treat the redrive dead letter queues (include, exclude, only)
+
 	log *log.Log // Custom logger
 }
 
+// sqsRedrivePolicy is the redrive policy attribute of a queue
+type sqsRedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+}
+
 // sqsCreator creates the the sqs gatherer creator
 type sqsCreator struct{}
 
@@ -68,40 +94,76 @@ func NewSQS(ctx context.Context, opts map[string]interface{}) (s *SQS, err error
 		}
 	}()
 
-	s = &SQS{}
+	s = &SQS{
+		deadLetter: sqsDeadLetterInclude,
+	}
 
 	// Prepare ops
 	var ok bool
 
-	// Set each option with the correct type
-	if s.QueueURL, ok = opts[queueURLOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", queueURLOpt)
+	// Set each option with the correct type, the queues can be set by URL, by URL list
+	// or by name prefix
+	if v, ok := opts[queueURLOpt]; ok {
+		s.QueueURL = v.(string)
+		if s.QueueURL != "" {
+			s.queueURLs = append(s.queueURLs, s.QueueURL)
+		}
 	}
 
-	if s.QueueURL == "" {
-		return nil, fmt.Errorf("%s configuration opt is required", queueURLOpt)
+	if v, ok := opts[queueURLsOpt]; ok {
+		for _, u := range v.([]interface{}) {
+			if u.(string) == "" {
+				return nil, fmt.Errorf("%s configuration opt is wrong", queueURLsOpt)
+			}
+			s.queueURLs = append(s.queueURLs, u.(string))
+		}
 	}
 
-	if s.QueueProperty, ok = opts[queuePropertyOpt].(string); !ok {
-		return nil, fmt.Errorf("%s configuration opt is required", queuePropertyOpt)
+	if v, ok := opts[queueNamePrefixOpt]; ok {
+		s.queueNamePrefix = v.(string)
 	}
 
-	if s.QueueProperty == "" {
-		return nil, fmt.Errorf("%s configuration opt is required", queuePropertyOpt)
+	if len(s.queueURLs) == 0 && s.queueNamePrefix == "" {
+		return nil, fmt.Errorf("%s, %s or %s configuration opt is required", queueURLOpt, queueURLsOpt, queueNamePrefixOpt)
 	}
 
-	// Check queue property correct
-	var valid bool
-	switch s.QueueProperty {
-	case
-		sqs.QueueAttributeNameApproximateNumberOfMessages,
-		sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
-		sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:
-		valid = true
+	// The properties will be summed
+	if v, ok := opts[queuePropertyOpt]; ok {
+		s.QueueProperty = v.(string)
+		if s.QueueProperty != "" {
+			s.properties = append(s.properties, s.QueueProperty)
+		}
 	}
 
-	if !valid {
-		return nil, fmt.Errorf("%s configuration opt is wrong", queuePropertyOpt)
+	if v, ok := opts[queuePropertiesOpt]; ok {
+		for _, p := range v.([]interface{}) {
+			s.properties = append(s.properties, p.(string))
+		}
+	}
+
+	if len(s.properties) == 0 {
+		return nil, fmt.Errorf("%s or %s configuration opt is required", queuePropertyOpt, queuePropertiesOpt)
+	}
+
+	// Check queue properties correct
+	for _, p := range s.properties {
+		switch p {
+		case
+			sqs.QueueAttributeNameApproximateNumberOfMessages,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:
+		default:
+			return nil, fmt.Errorf("%s configuration opt is wrong", queuePropertyOpt)
+		}
+	}
+
+	if v, ok := opts[deadLetterOpt]; ok {
+		s.deadLetter = v.(string)
+	}
+	switch s.deadLetter {
+	case sqsDeadLetterInclude, sqsDeadLetterExclude, sqsDeadLetterOnly:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", deadLetterOpt)
 	}
 
 	region, ok := opts[awsRegionOpt].(string)
@@ -144,16 +206,15 @@ type sqsResult struct {
 	err    error
 }
 
-// sqsGather calls to SQS and returns in a channel the result or error
-func (s *SQS) sqsGather(resultChan chan<- sqsResult, wg *sync.WaitGroup) {
+// sqsGather calls to SQS and returns in a channel the result (the sum of the properties)
+// or error
+func (s *SQS) sqsGather(queueURL string, resultChan chan<- sqsResult, wg *sync.WaitGroup) {
 	defer wg.Done()
 	s.log.Debugf("Gathering input from AWS API (running concurrently)")
 
 	params := &sqs.GetQueueAttributesInput{
-		QueueUrl: aws.String(s.QueueURL),
-		AttributeNames: []*string{
-			aws.String(s.QueueProperty),
-		},
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: aws.StringSlice(s.properties),
 	}
 
 	// Get the sqs properties
@@ -163,26 +224,156 @@ func (s *SQS) sqsGather(resultChan chan<- sqsResult, wg *sync.WaitGroup) {
 		return
 	}
 
-	// Get property
-	prop, ok := resp.Attributes[s.QueueProperty]
-	if !ok {
-		resultChan <- sqsResult{0, fmt.Errorf("Error retrieving SQS queue properties")}
-		return
-	}
-	// Convert string to integer
-	propInt, err := strconv.Atoi(aws.StringValue(prop))
-	if err != nil {
-		resultChan <- sqsResult{0, fmt.Errorf("Error retrieving SQS queue properties")}
-		return
+	total := 0
+	for _, p := range s.properties {
+		// Get property
+		prop, ok := resp.Attributes[p]
+		if !ok {
+			resultChan <- sqsResult{0, fmt.Errorf("Error retrieving SQS queue properties")}
+			return
+		}
+		// Convert string to integer
+		propInt, err := strconv.Atoi(aws.StringValue(prop))
+		if err != nil {
+			resultChan <- sqsResult{0, fmt.Errorf("Error retrieving SQS queue properties")}
+			return
+		}
+		total += propInt
 	}
-	resultChan <- sqsResult{propInt, nil}
+	resultChan <- sqsResult{total, nil}
 	return
 }
 
-// Gather retrieves the SQS properties and returns the quantity of the desired property
+// queues returns the URLs of the queues that need to be gathered, the configured ones
+// and the ones that match the name prefix
+func (s *SQS) queues() ([]string, error) {
+	urls := map[string]bool{}
+	for _, u := range s.queueURLs {
+		urls[u] = true
+	}
+
+	if s.queueNamePrefix != "" {
+		resp, err := s.client.ListQueues(&sqs.ListQueuesInput{
+			QueueNamePrefix: aws.String(s.queueNamePrefix),
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Don't gather a part of the queues if the list is truncated
+		if len(resp.QueueUrls) >= sqsListQueuesMax {
+			return nil, fmt.Errorf("the list of SQS queues with %s name prefix is truncated at %d queues", s.queueNamePrefix, sqsListQueuesMax)
+		}
+		for _, u := range resp.QueueUrls {
+			urls[aws.StringValue(u)] = true
+		}
+	}
+
+	res := make([]string, 0, len(urls))
+	for u := range urls {
+		res = append(res, u)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// deadLetterQueues returns the URLs of the redrive dead letter queues of the queues
+func (s *SQS) deadLetterQueues(queueURLs []string) (map[string]bool, error) {
+	dlqs := map[string]bool{}
+	arns := map[string]bool{}
+
+	for _, u := range queueURLs {
+		resp, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(u),
+			AttributeNames: []*string{aws.String(sqs.QueueAttributeNameRedrivePolicy)},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Queues without redrive policy don't have dead letter queue
+		rp, ok := resp.Attributes[sqs.QueueAttributeNameRedrivePolicy]
+		if !ok || aws.StringValue(rp) == "" {
+			continue
+		}
+		policy := sqsRedrivePolicy{}
+		if err := json.Unmarshal([]byte(aws.StringValue(rp)), &policy); err != nil {
+			return nil, fmt.Errorf("wrong redrive policy on %s queue: %s", u, err)
+		}
+		arns[policy.DeadLetterTargetArn] = true
+	}
+
+	for arn := range arns {
+		// ARN format: arn:aws:sqs:region:account:name
+		parts := strings.Split(arn, ":")
+		if len(parts) != 6 || parts[2] != "sqs" {
+			return nil, fmt.Errorf("wrong dead letter queue ARN: %s", arn)
+		}
+		resp, err := s.client.GetQueueUrl(&sqs.GetQueueUrlInput{
+			QueueName:              aws.String(parts[5]),
+			QueueOwnerAWSAccountId: aws.String(parts[4]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		dlqs[aws.StringValue(resp.QueueUrl)] = true
+	}
+
+	return dlqs, nil
+}
+
+// Gather retrieves the SQS properties of all the queues and returns the sum of the
+// desired properties
 func (s *SQS) Gather(_ context.Context) (types.Quantity, error) {
 	q := types.Quantity{Q: 0}
 
+	urls, err := s.queues()
+	if err != nil {
+		return q, err
+	}
+
+	// Filter the queues using the dead letter queues
+	if s.deadLetter != sqsDeadLetterInclude {
+		dlqs, err := s.deadLetterQueues(urls)
+		if err != nil {
+			return q, err
+		}
+
+		filtered := []string{}
+		if s.deadLetter == sqsDeadLetterOnly {
+			for u := range dlqs {
+				filtered = append(filtered, u)
+			}
+			sort.Strings(filtered)
+		} else {
+			for _, u := range urls {
+				if !dlqs[u] {
+					filtered = append(filtered, u)
+				}
+			}
+		}
+		urls = filtered
+	}
+
+	if len(urls) == 0 {
+		return q, fmt.Errorf("there aren't SQS queues to gather")
+	}
+
+	for _, u := range urls {
+		v, err := s.queueGather(u)
+		if err != nil {
+			return q, err
+		}
+		q.Q += v
+	}
+
+	s.log.Debugf("Retrieved sqs input: %s", q)
+
+	return q, nil
+}
+
+// queueGather retrieves the SQS properties of a queue and returns the quantity of the
+// desired properties
+func (s *SQS) queueGather(queueURL string) (int64, error) {
 	// Prepare sync and result channel for concurrent calls to the API
 	var wg sync.WaitGroup
 	wg.Add(sqsCallTimes)
@@ -192,7 +383,7 @@ func (s *SQS) Gather(_ context.Context) (types.Quantity, error) {
 
 	// Make the multiple calls
 	for i := 0; i < sqsCallTimes; i++ {
-		go s.sqsGather(resChan, &wg)
+		go s.sqsGather(queueURL, resChan, &wg)
 	}
 
 	// Run the result "aggregator"
@@ -217,13 +408,10 @@ func (s *SQS) Gather(_ context.Context) (types.Quantity, error) {
 	for _, r := range results {
 		// Return the first error
 		if r.err != nil {
-			return q, r.err
+			return 0, r.err
 		}
 		max = math.Max(max, float64(r.result))
 	}
-	q.Q = int64(max)
-
-	s.log.Debugf("Retrieved sqs input: %s", q)
 
-	return q, nil
+	return int64(max), nil
 }
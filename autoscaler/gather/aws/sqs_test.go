@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -306,3 +308,344 @@ func TestSQSGatherMaxFromMultipleConcurrentCalls(t *testing.T) {
 		}
 	}
 }
+
+func TestSQSMultipleQueuesCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantQueueURLs   []string
+		wantProperties  []string
+		wantDeadLetter  string
+		wantQueuePrefix string
+		wantError       bool
+	}{
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt: "us-west-2",
+				queueURLsOpt: []interface{}{
+					"https://sqs.us-west-2.amazonaws.com/00000000000/tenant1",
+					"https://sqs.us-west-2.amazonaws.com/00000000000/tenant2",
+				},
+				queuePropertyOpt: "ApproximateNumberOfMessages",
+			},
+			wantQueueURLs: []string{
+				"https://sqs.us-west-2.amazonaws.com/00000000000/tenant1",
+				"https://sqs.us-west-2.amazonaws.com/00000000000/tenant2",
+			},
+			wantProperties: []string{"ApproximateNumberOfMessages"},
+			wantDeadLetter: "include",
+		},
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:       "us-west-2",
+				queueNamePrefixOpt: "tenant",
+				queuePropertiesOpt: []interface{}{
+					"ApproximateNumberOfMessages",
+					"ApproximateNumberOfMessagesNotVisible",
+					"ApproximateNumberOfMessagesDelayed",
+				},
+				deadLetterOpt: "exclude",
+			},
+			wantProperties: []string{
+				"ApproximateNumberOfMessages",
+				"ApproximateNumberOfMessagesNotVisible",
+				"ApproximateNumberOfMessagesDelayed",
+			},
+			wantQueuePrefix: "tenant",
+			wantDeadLetter:  "exclude",
+		},
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:       "us-west-2",
+				queueURLOpt:        "https://sqs.us-west-2.amazonaws.com/00000000000/jobs",
+				queueURLsOpt:       []interface{}{"https://sqs.us-west-2.amazonaws.com/00000000000/tenant1"},
+				queueNamePrefixOpt: "tenant",
+				queuePropertyOpt:   "ApproximateNumberOfMessages",
+				queuePropertiesOpt: []interface{}{"ApproximateNumberOfMessagesDelayed"},
+				deadLetterOpt:      "only",
+			},
+			wantQueueURLs: []string{
+				"https://sqs.us-west-2.amazonaws.com/00000000000/jobs",
+				"https://sqs.us-west-2.amazonaws.com/00000000000/tenant1",
+			},
+			wantProperties:  []string{"ApproximateNumberOfMessages", "ApproximateNumberOfMessagesDelayed"},
+			wantQueuePrefix: "tenant",
+			wantDeadLetter:  "only",
+		},
+		// Missing queues
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:     "us-west-2",
+				queueURLsOpt:     []interface{}{},
+				queuePropertyOpt: "ApproximateNumberOfMessages",
+			},
+			wantError: true,
+		},
+		// Wrong queues
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:     "us-west-2",
+				queueURLsOpt:     []interface{}{""},
+				queuePropertyOpt: "ApproximateNumberOfMessages",
+			},
+			wantError: true,
+		},
+		// Missing properties
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:       "us-west-2",
+				queueNamePrefixOpt: "tenant",
+				queuePropertiesOpt: []interface{}{},
+			},
+			wantError: true,
+		},
+		// Wrong properties
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:       "us-west-2",
+				queueNamePrefixOpt: "tenant",
+				queuePropertiesOpt: []interface{}{"ApproximateNumberOfMessages", "VisibilityTimeout"},
+			},
+			wantError: true,
+		},
+		// Wrong dead letter mode
+		{
+			opts: map[string]interface{}{
+				awsRegionOpt:       "us-west-2",
+				queueNamePrefixOpt: "tenant",
+				queuePropertyOpt:   "ApproximateNumberOfMessages",
+				deadLetterOpt:      "subtract",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		s, err := NewSQS(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(s.queueURLs, test.wantQueueURLs) {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantQueueURLs, s.queueURLs)
+		}
+
+		if !reflect.DeepEqual(s.properties, test.wantProperties) {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantProperties, s.properties)
+		}
+
+		if s.queueNamePrefix != test.wantQueuePrefix {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantQueuePrefix, s.queueNamePrefix)
+		}
+
+		if s.deadLetter != test.wantDeadLetter {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantDeadLetter, s.deadLetter)
+		}
+	}
+}
+
+func TestSQSMultipleQueuesGather(t *testing.T) {
+	const (
+		tenant1    = "https://sqs.us-west-2.amazonaws.com/00000000000/tenant1"
+		tenant2    = "https://sqs.us-west-2.amazonaws.com/00000000000/tenant2"
+		tenant3    = "https://sqs.us-west-2.amazonaws.com/00000000000/tenant3"
+		tenantsDLQ = "https://sqs.us-west-2.amazonaws.com/00000000000/tenant-dlq"
+	)
+	redrive := `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:00000000000:tenant-dlq","maxReceiveCount":5}`
+
+	attributes := map[string]map[string]string{
+		tenant1: {
+			"ApproximateNumberOfMessages":           "10",
+			"ApproximateNumberOfMessagesNotVisible": "5",
+			"ApproximateNumberOfMessagesDelayed":    "1",
+			"RedrivePolicy":                         redrive,
+		},
+		tenant2: {
+			"ApproximateNumberOfMessages":           "20",
+			"ApproximateNumberOfMessagesNotVisible": "6",
+			"ApproximateNumberOfMessagesDelayed":    "2",
+			"RedrivePolicy":                         redrive,
+		},
+		tenant3: {
+			"ApproximateNumberOfMessages":           "30",
+			"ApproximateNumberOfMessagesNotVisible": "7",
+			"ApproximateNumberOfMessagesDelayed":    "3",
+		},
+		tenantsDLQ: {
+			"ApproximateNumberOfMessages":           "100",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+		},
+	}
+
+	tests := []struct {
+		queueURLs  []interface{}
+		listed     []string
+		properties []interface{}
+		deadLetter string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{
+			queueURLs:  []interface{}{tenant1, tenant2},
+			properties: []interface{}{"ApproximateNumberOfMessages"},
+			deadLetter: "include",
+			wantQ:      30,
+		},
+		{
+			queueURLs:  []interface{}{tenant1, tenant2, tenant3},
+			properties: []interface{}{"ApproximateNumberOfMessages", "ApproximateNumberOfMessagesNotVisible", "ApproximateNumberOfMessagesDelayed"},
+			deadLetter: "include",
+			wantQ:      84,
+		},
+		// Listed queues are deduplicated
+		{
+			queueURLs:  []interface{}{tenant1},
+			listed:     []string{tenant1, tenant2, tenantsDLQ},
+			properties: []interface{}{"ApproximateNumberOfMessages"},
+			deadLetter: "include",
+			wantQ:      130,
+		},
+		{
+			listed:     []string{tenant1, tenant2, tenant3, tenantsDLQ},
+			properties: []interface{}{"ApproximateNumberOfMessages", "ApproximateNumberOfMessagesNotVisible"},
+			deadLetter: "exclude",
+			wantQ:      78,
+		},
+		{
+			listed:     []string{tenant1, tenant2, tenant3, tenantsDLQ},
+			properties: []interface{}{"ApproximateNumberOfMessages"},
+			deadLetter: "only",
+			wantQ:      100,
+		},
+		// Without dead letter queues
+		{
+			queueURLs:  []interface{}{tenant3},
+			properties: []interface{}{"ApproximateNumberOfMessages"},
+			deadLetter: "only",
+			wantError:  true,
+		},
+		// Without queues
+		{
+			listed:     []string{},
+			properties: []interface{}{"ApproximateNumberOfMessages"},
+			deadLetter: "include",
+			wantError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSQS := sdk.NewMockSQSAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockGetQueuesAttributes(t, mockSQS, attributes)
+		awsMock.MockGetQueueURL(t, mockSQS, "00000000000", map[string]string{"tenant-dlq": tenantsDLQ})
+
+		ops := map[string]interface{}{
+			awsRegionOpt:       "us-west-2",
+			queuePropertiesOpt: test.properties,
+			deadLetterOpt:      test.deadLetter,
+		}
+		if test.queueURLs != nil {
+			ops[queueURLsOpt] = test.queueURLs
+		}
+		if test.listed != nil {
+			ops[queueNamePrefixOpt] = "tenant"
+			awsMock.MockListQueues(t, mockSQS, "tenant", test.listed...)
+		}
+
+		s, err := NewSQS(context.TODO(), ops)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		s.client = mockSQS
+
+		res, err := s.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gathering should give error, it didn't: %+v", test, res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gathering shouldn't give error: %v", test, err)
+			continue
+		}
+		if res.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Gathered quantity doesn't look good, got: %d want: %d", test, res.Q, test.wantQ)
+		}
+	}
+}
+
+func TestSQSMultipleQueuesGatherError(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSQS := sdk.NewMockSQSAPI(ctrl)
+
+	// Set our mock desired result
+	awsMock.MockListQueuesError(t, mockSQS)
+
+	ops := map[string]interface{}{
+		awsRegionOpt:       "us-west-2",
+		queueNamePrefixOpt: "tenant",
+		queuePropertyOpt:   "ApproximateNumberOfMessages",
+	}
+
+	s, err := NewSQS(context.TODO(), ops)
+	if err != nil {
+		t.Fatalf("\n-  Creation shouldn't give error: %v", err)
+	}
+	s.client = mockSQS
+
+	_, err = s.Gather(context.TODO())
+	if err == nil {
+		t.Errorf("\n-  Gathering should give error, it didn't")
+	}
+}
+
+func TestSQSMultipleQueuesGatherTruncatedList(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSQS := sdk.NewMockSQSAPI(ctrl)
+
+	// Set our mock desired result, the maximum number of queues of the list
+	listed := make([]string, sqsListQueuesMax)
+	for i := range listed {
+		listed[i] = fmt.Sprintf("https://sqs.us-west-2.amazonaws.com/00000000000/tenant-%d", i)
+	}
+	awsMock.MockListQueues(t, mockSQS, "tenant", listed...)
+
+	ops := map[string]interface{}{
+		awsRegionOpt:       "us-west-2",
+		queueNamePrefixOpt: "tenant",
+		queuePropertyOpt:   "ApproximateNumberOfMessages",
+	}
+
+	s, err := NewSQS(context.TODO(), ops)
+	if err != nil {
+		t.Fatalf("\n-  Creation shouldn't give error: %v", err)
+	}
+	s.client = mockSQS
+
+	_, err = s.Gather(context.TODO())
+	if err == nil {
+		t.Errorf("\n-  Gathering should give error, it didn't")
+	}
+}
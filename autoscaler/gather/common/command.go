@@ -0,0 +1,182 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const (
+	// Opts
+	cmdCommandOpt = "command"
+	cmdArgsOpt    = "args"
+	cmdEnvOpt     = "env"
+	cmdTimeoutOpt = "timeout"
+	cmdRegexOpt   = "regex"
+
+	// Defaults
+	cmdDefaultTimeout = 10 * time.Second
+
+	// The time to wait for the output pipes after the command is killed
+	cmdWaitDelay = 1 * time.Second
+
+	// id name
+	commandRegName = "command"
+)
+
+// Command will run an external command and will get the quantity from its output
+type Command struct {
+	path    string
+	args    []string
+	env     []string
+	timeout time.Duration
+	regex   *regexp.Regexp // Optional regex to extract the quantity from the output
+
+	log *log.Log // custom logger
+}
+
+type commandCreator struct{}
+
+func (c *commandCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewCommand(ctx, opts)
+}
+
+// Autoregister on gatherers creator
+func init() {
+	gather.Register(commandRegName, &commandCreator{})
+}
+
+// NewCommand creates a command Gatherer
+func NewCommand(ctx context.Context, opts map[string]interface{}) (c *Command, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	c = &Command{
+		timeout: cmdDefaultTimeout,
+		args:    []string{},
+		// The command inherits the environment of ladder
+		env: os.Environ(),
+	}
+
+	var ok bool
+
+	// Set each option with the correct type
+	if c.path, ok = opts[cmdCommandOpt].(string); !ok || c.path == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", cmdCommandOpt)
+	}
+
+	if v, ok := opts[cmdArgsOpt]; ok {
+		for _, a := range v.([]interface{}) {
+			c.args = append(c.args, a.(string))
+		}
+	}
+
+	if v, ok := opts[cmdEnvOpt]; ok {
+		for k, ev := range v.(map[interface{}]interface{}) {
+			c.env = append(c.env, fmt.Sprintf("%s=%s", k.(string), ev.(string)))
+		}
+	}
+
+	if v, ok := opts[cmdTimeoutOpt]; ok {
+		if c.timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", cmdTimeoutOpt, err)
+		}
+	}
+	if c.timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", cmdTimeoutOpt)
+	}
+
+	if v, ok := opts[cmdRegexOpt]; ok {
+		if c.regex, err = regexp.Compile(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", cmdRegexOpt, err)
+		}
+		if c.regex.NumSubexp() > 1 {
+			return nil, fmt.Errorf("%s configuration opt should have one capture group at most", cmdRegexOpt)
+		}
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	c.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       commandRegName,
+	})
+
+	return c, nil
+}
+
+// parse gets the quantity from the command output
+func (c *Command) parse(out string) (int64, error) {
+	res := strings.TrimSpace(out)
+
+	if c.regex != nil {
+		m := c.regex.FindStringSubmatch(out)
+		if m == nil {
+			return 0, fmt.Errorf("regex didn't match the command output")
+		}
+		// If there is a capture group use it, if not use the whole match
+		res = strings.TrimSpace(m[len(m)-1])
+	}
+
+	v, err := strconv.ParseInt(res, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("command output is not an integer: %s", res)
+	}
+	return v, nil
+}
+
+// Gather runs the command and returns the quantity from its output, the command
+// will be killed if the context is cancelled or the timeout is reached
+func (c *Command) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.path, c.args...)
+	cmd.Env = c.env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Kill the processes created by the command too, if not they would keep the output
+	// pipes open and the command wouldn't finish until they exit
+	killProcessGroup(cmd)
+	cmd.WaitDelay = cmdWaitDelay
+
+	c.log.Debugf("Running %s command", c.path)
+	if err := cmd.Run(); err != nil {
+		// If the context finished the command was killed
+		if ctx.Err() != nil {
+			return q, fmt.Errorf("command %s was killed: %s", c.path, ctx.Err())
+		}
+		return q, fmt.Errorf("command %s failed: %s: %s", c.path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	v, err := c.parse(stdout.String())
+	if err != nil {
+		return q, err
+	}
+	q.Q = v
+
+	c.log.Debugf("Retrieved command input: %s", q)
+
+	return q, nil
+}
@@ -0,0 +1,198 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestCommandCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantArgs    int
+		wantTimeout time.Duration
+		wantError   bool
+	}{
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+			},
+			wantTimeout: cmdDefaultTimeout,
+		},
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+				cmdArgsOpt:    []interface{}{"-h", "127.0.0.1", "llen", "jobs"},
+				cmdEnvOpt:     map[interface{}]interface{}{"REDISCLI_AUTH": "secret"},
+				cmdTimeoutOpt: "2s",
+				cmdRegexOpt:   `(\d+)`,
+			},
+			wantArgs:    4,
+			wantTimeout: 2 * time.Second,
+		},
+		// Missing command
+		{
+			opts: map[string]interface{}{
+				cmdArgsOpt: []interface{}{"llen", "jobs"},
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+				cmdTimeoutOpt: "0s",
+			},
+			wantError: true,
+		},
+		// Wrong regex
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+				cmdRegexOpt:   `(\d+`,
+			},
+			wantError: true,
+		},
+		// Too many capture groups
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+				cmdRegexOpt:   `(\d+) (\d+)`,
+			},
+			wantError: true,
+		},
+		// Wrong args
+		{
+			opts: map[string]interface{}{
+				cmdCommandOpt: "redis-cli",
+				cmdArgsOpt:    []interface{}{"llen", 1},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		c, err := NewCommand(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(c.args) != test.wantArgs || c.timeout != test.wantTimeout {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantArgs, test.wantTimeout, len(c.args), c.timeout)
+		}
+	}
+}
+
+func TestCommandGather(t *testing.T) {
+	tests := []struct {
+		script string
+		regex  string
+
+		wantValue int64
+		wantError bool
+	}{
+		{`echo 42`, "", 42, false},
+		{`echo "  -7  "`, "", -7, false},
+		{`echo "$LADDER_TEST_QUEUE"`, "", 1234, false},
+		{`echo "jobs: 10 pending"`, `\d+`, 10, false},
+		{`printf "active: 3\nreserved: 15\n"`, `reserved: (\d+)`, 15, false},
+		// Not matching regex is bad
+		{`echo "nothing"`, `(\d+)`, 0, true},
+		// Not integers are bad
+		{`echo 4.2`, "", 0, true},
+		{`echo "ten"`, "", 0, true},
+		{`true`, "", 0, true},
+		// Not zero exit codes are bad even if the output is correct
+		{`echo 42; exit 1`, "", 0, true},
+		{`echo "oops" >&2; exit 3`, "", 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			cmdCommandOpt: "/bin/sh",
+			cmdArgsOpt:    []interface{}{"-c", test.script},
+			cmdEnvOpt:     map[interface{}]interface{}{"LADDER_TEST_QUEUE": "1234"},
+		}
+		if test.regex != "" {
+			opts[cmdRegexOpt] = test.regex
+		}
+
+		c, err := NewCommand(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		c.log = log.New()
+
+		q, err := c.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantValue {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantValue, q.Q)
+		}
+	}
+}
+
+func TestCommandGatherKill(t *testing.T) {
+	tests := []struct {
+		command string
+		args    []interface{}
+		timeout string
+		cancel  bool
+	}{
+		{"sleep", []interface{}{"10"}, "100ms", false},
+		{"sleep", []interface{}{"10"}, "10s", true},
+		// The shell child processes keep the output pipes open
+		{"/bin/sh", []interface{}{"-c", "sleep 5; echo 1"}, "200ms", false},
+		{"/bin/sh", []interface{}{"-c", "sleep 5; echo 1"}, "10s", true},
+	}
+
+	for _, test := range tests {
+		c, err := NewCommand(context.TODO(), map[string]interface{}{
+			cmdCommandOpt: test.command,
+			cmdArgsOpt:    test.args,
+			cmdTimeoutOpt: test.timeout,
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		c.log = log.New()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if test.cancel {
+			time.AfterFunc(100*time.Millisecond, cancel)
+		}
+
+		start := time.Now()
+		_, err = c.Gather(ctx)
+		cancel()
+
+		if err == nil {
+			t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+		}
+		if time.Since(start) > 2*time.Second {
+			t.Errorf("\n- %+v\n  Command should be killed, it wasn't", test)
+		}
+	}
+}
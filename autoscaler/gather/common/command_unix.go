@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package common
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// killProcessGroup runs the command on its own process group and kills the whole group
+// when the command is cancelled
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
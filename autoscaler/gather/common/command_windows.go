@@ -0,0 +1,7 @@
+package common
+
+import "os/exec"
+
+// killProcessGroup does nothing on windows, only the command process is killed when the
+// command is cancelled
+func killProcessGroup(cmd *exec.Cmd) {}
@@ -0,0 +1,263 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+const (
+	// Opts
+	csAddressOpt            = "address"
+	csServiceOpt            = "service"
+	csTagsOpt               = "tags"
+	csDatacenterOpt         = "datacenter"
+	csStatusOpt             = "status"
+	csTokenOpt              = "token"
+	csTimeoutOpt            = "timeout"
+	csCAFileOpt             = "ca_file"
+	csInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// Health statuses
+	csStatusPassing     = "passing"
+	csStatusWarning     = "warning"
+	csStatusCritical    = "critical"
+	csStatusMaintenance = "maintenance"
+
+	// Defaults
+	csDefaultAddress = "http://127.0.0.1:8500"
+	csDefaultStatus  = csStatusPassing
+	csDefaultTimeout = 10 * time.Second
+
+	// id name
+	consulServiceRegName = "consul_service"
+)
+
+// csServiceEntry is an instance of the Consul health service API response
+type csServiceEntry struct {
+	Service struct {
+		ID   string   `json:"ID"`
+		Tags []string `json:"Tags"`
+	} `json:"Service"`
+	Checks []struct {
+		Status string `json:"Status"`
+	} `json:"Checks"`
+}
+
+// status returns the aggregated status of the instance checks, like Consul does the worst
+// status wins and the instances without checks are passing
+func (c csServiceEntry) status() string {
+	st := csStatusPassing
+	for _, ch := range c.Checks {
+		switch ch.Status {
+		case csStatusCritical, csStatusMaintenance:
+			return csStatusCritical
+		case csStatusWarning:
+			st = csStatusWarning
+		}
+	}
+	return st
+}
+
+// hasTags returns true if the instance has all the tags
+func (c csServiceEntry) hasTags(tags []string) bool {
+	for _, t := range tags {
+		found := false
+		for _, st := range c.Service.Tags {
+			if st == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsulService will return the number of instances of a Consul service on a health status
+type ConsulService struct {
+	address    string
+	service    string   // The Consul service name
+	tags       []string // The tags the instances need to have
+	datacenter string   // The datacenter of the instances, by default the agent one
+	status     string   // The health status of the instances (passing, warning, critical)
+	token      string
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+type consulServiceCreator struct{}
+
+func (c *consulServiceCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewConsulService(ctx, opts)
+}
+
+// Autoregister on gatherers creator
+func init() {
+	gather.Register(consulServiceRegName, &consulServiceCreator{})
+}
+
+// NewConsulService creates a Consul service Gatherer
+func NewConsulService(ctx context.Context, opts map[string]interface{}) (c *ConsulService, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	c = &ConsulService{
+		address: csDefaultAddress,
+		status:  csDefaultStatus,
+	}
+
+	var ok bool
+
+	// Set each option with the correct type
+	if v, ok := opts[csAddressOpt]; ok {
+		c.address = strings.TrimRight(v.(string), "/")
+	}
+	u, err := url.ParseRequestURI(c.address)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", csAddressOpt, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s is not an HTTP address", csAddressOpt, c.address)
+	}
+
+	if c.service, ok = opts[csServiceOpt].(string); !ok || c.service == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", csServiceOpt)
+	}
+
+	if v, ok := opts[csTagsOpt]; ok {
+		for _, t := range v.([]interface{}) {
+			c.tags = append(c.tags, t.(string))
+		}
+	}
+
+	if v, ok := opts[csDatacenterOpt]; ok {
+		c.datacenter = v.(string)
+	}
+
+	if v, ok := opts[csStatusOpt]; ok {
+		c.status = v.(string)
+	}
+	switch c.status {
+	case csStatusPassing, csStatusWarning, csStatusCritical:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", csStatusOpt)
+	}
+
+	if v, ok := opts[csTokenOpt]; ok {
+		c.token = v.(string)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[csCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[csInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", csCAFileOpt, err)
+	}
+
+	timeout := csDefaultTimeout
+	if v, ok := opts[csTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", csTimeoutOpt, err)
+		}
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", csTimeoutOpt)
+	}
+	c.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	c.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       consulServiceRegName,
+	})
+
+	return c, nil
+}
+
+// Gather returns the number of instances of the service on the health status
+func (c *ConsulService) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	params := url.Values{}
+	if c.datacenter != "" {
+		params.Set("dc", c.datacenter)
+	}
+	// Old Consul versions only filter by the first tag, the tags are checked again after
+	for _, t := range c.tags {
+		params.Add("tag", t)
+	}
+	u := fmt.Sprintf("%s/v1/health/service/%s", c.address, url.PathEscape(c.service))
+	if len(params) > 0 {
+		u = u + "?" + params.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return q, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	c.log.Debugf("Calling %s", u)
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return q, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return q, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return q, fmt.Errorf("%s returned %d status code: %s", u, resp.StatusCode, b)
+	}
+
+	entries := []csServiceEntry{}
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return q, fmt.Errorf("error decoding response: %s", err)
+	}
+
+	for _, e := range entries {
+		if e.hasTags(c.tags) && e.status() == c.status {
+			q.Q++
+		}
+	}
+
+	c.log.Debugf("Retrieved consul service input: %s", q)
+
+	return q, nil
+}
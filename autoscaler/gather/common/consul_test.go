@@ -0,0 +1,195 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestConsulServiceCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantAddress string
+		wantStatus  string
+		wantError   bool
+	}{
+		{
+			opts:        map[string]interface{}{csServiceOpt: "render-worker"},
+			wantAddress: csDefaultAddress,
+			wantStatus:  csStatusPassing,
+		},
+		{
+			opts: map[string]interface{}{
+				csAddressOpt:    "https://consul.prod.themotion.lan:8501/",
+				csServiceOpt:    "render-worker",
+				csTagsOpt:       []interface{}{"gpu", "v2"},
+				csDatacenterOpt: "dc2",
+				csStatusOpt:     "critical",
+				csTokenOpt:      "secret",
+				csTimeoutOpt:    "2s",
+			},
+			wantAddress: "https://consul.prod.themotion.lan:8501",
+			wantStatus:  csStatusCritical,
+		},
+		// Missing service
+		{opts: map[string]interface{}{csAddressOpt: "http://127.0.0.1:8500"}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{csServiceOpt: "render-worker", csAddressOpt: "consul:8500"}, wantError: true},
+		{opts: map[string]interface{}{csServiceOpt: "render-worker", csStatusOpt: "maintenance"}, wantError: true},
+		{opts: map[string]interface{}{csServiceOpt: "render-worker", csTagsOpt: "gpu"}, wantError: true},
+		{opts: map[string]interface{}{csServiceOpt: "render-worker", csTimeoutOpt: "10"}, wantError: true},
+	}
+
+	for _, test := range tests {
+		c, err := NewConsulService(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if c.address != test.wantAddress || c.status != test.wantStatus {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantAddress, test.wantStatus, c.address, c.status)
+		}
+	}
+}
+
+// consulInstance returns a health service API instance with the checks statuses
+func consulInstance(id string, tags []string, statuses ...string) string {
+	checks := make([]string, len(statuses))
+	for i, s := range statuses {
+		checks[i] = fmt.Sprintf(`{"Node": "node-%s", "CheckID": "check-%d", "Status": "%s"}`, id, i, s)
+	}
+	tagsJ := `[]`
+	if len(tags) > 0 {
+		tagsJ = `["` + strings.Join(tags, `","`) + `"]`
+	}
+	return fmt.Sprintf(`{"Node": {"Node": "node-%s"}, "Service": {"ID": "%s", "Service": "render-worker", "Tags": %s}, "Checks": [%s]}`,
+		id, id, tagsJ, strings.Join(checks, ","))
+}
+
+func TestConsulServiceGather(t *testing.T) {
+	instances := map[string][]string{
+		"dc1": {
+			consulInstance("w1", []string{"gpu", "v2"}, "passing", "passing"),
+			consulInstance("w2", []string{"gpu"}, "passing", "warning"),
+			consulInstance("w3", []string{"cpu", "v2"}, "passing"),
+			consulInstance("w4", []string{"gpu", "v2"}, "passing", "critical"),
+			consulInstance("w5", []string{"cpu"}, "maintenance", "passing"),
+			consulInstance("w6", nil),
+		},
+		"dc2": {
+			consulInstance("w7", []string{"gpu"}, "passing"),
+		},
+	}
+
+	tests := []struct {
+		service    string
+		tags       []interface{}
+		datacenter string
+		status     string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{"render-worker", nil, "", csStatusPassing, 3, false},
+		{"render-worker", nil, "", csStatusWarning, 1, false},
+		{"render-worker", nil, "", csStatusCritical, 2, false},
+		{"render-worker", []interface{}{"gpu"}, "", csStatusPassing, 1, false},
+		{"render-worker", []interface{}{"v2"}, "", csStatusPassing, 2, false},
+		{"render-worker", []interface{}{"gpu", "v2"}, "", csStatusCritical, 1, false},
+		{"render-worker", nil, "dc2", csStatusPassing, 1, false},
+		// Missing services don't have instances
+		{"upload-worker", nil, "", csStatusPassing, 0, false},
+		// Errors
+		{"render-worker", nil, "dc3", csStatusPassing, 0, true},
+		{"broken", nil, "", csStatusPassing, 0, true},
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Consul-Token") != "secret" {
+			t.Errorf("Wrong token, got: %s", r.Header.Get("X-Consul-Token"))
+		}
+
+		dc := r.URL.Query().Get("dc")
+		if dc == "" {
+			dc = "dc1"
+		}
+		switch r.URL.Path {
+		case "/v1/health/service/render-worker":
+			is, ok := instances[dc]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, "No path to datacenter")
+				return
+			}
+			// Filter by the first tag like old Consul versions do
+			if tag := r.URL.Query().Get("tag"); tag != "" {
+				fis := []string{}
+				for _, i := range is {
+					if strings.Contains(i, `"`+tag+`"`) {
+						fis = append(fis, i)
+					}
+				}
+				is = fis
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(is, ","))
+		case "/v1/health/service/broken":
+			fmt.Fprintf(w, `{"wrong": true}`)
+		default:
+			fmt.Fprintf(w, "[]")
+		}
+	}))
+	defer s.Close()
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			csAddressOpt: s.URL,
+			csServiceOpt: test.service,
+			csStatusOpt:  test.status,
+			csTokenOpt:   "secret",
+		}
+		if test.tags != nil {
+			opts[csTagsOpt] = test.tags
+		}
+		if test.datacenter != "" {
+			opts[csDatacenterOpt] = test.datacenter
+		}
+
+		c, err := NewConsulService(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		c.log = log.New()
+
+		q, err := c.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
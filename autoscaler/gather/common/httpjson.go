@@ -0,0 +1,244 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jmespath/go-jmespath"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	hjURLOpt                = "url"
+	hjMethodOpt             = "method"
+	hjHeadersOpt            = "headers"
+	hjBodyOpt               = "body"
+	hjExpressionOpt         = "expression"
+	hjTimeoutOpt            = "timeout"
+	hjUsernameOpt           = "username"
+	hjPasswordOpt           = "password"
+	hjBearerTokenOpt        = "bearer_token"
+	hjCAFileOpt             = "ca_file"
+	hjInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// Defaults
+	hjDefaultMethod  = http.MethodGet
+	hjDefaultTimeout = 10 * time.Second
+
+	// id name
+	httpJSONRegName = "http_json"
+)
+
+// HTTPJSON will call an HTTP endpoint that returns JSON and will extract the
+// quantity from the response using a JMESPath expression
+type HTTPJSON struct {
+	url         string
+	method      string
+	headers     map[string]string
+	body        string
+	expression  *jmespath.JMESPath
+	username    string
+	password    string
+	bearerToken string
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+type httpJSONCreator struct{}
+
+func (h *httpJSONCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewHTTPJSON(ctx, opts)
+}
+
+// Autoregister on gatherers creator
+func init() {
+	gather.Register(httpJSONRegName, &httpJSONCreator{})
+}
+
+// NewHTTPJSON creates an HTTP JSON Gatherer
+func NewHTTPJSON(ctx context.Context, opts map[string]interface{}) (h *HTTPJSON, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	h = &HTTPJSON{
+		method:  hjDefaultMethod,
+		headers: map[string]string{},
+	}
+
+	var ok bool
+
+	// Set each option with the correct type
+	if h.url, ok = opts[hjURLOpt].(string); !ok || h.url == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", hjURLOpt)
+	}
+	if _, err = url.ParseRequestURI(h.url); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", hjURLOpt, err)
+	}
+
+	if v, ok := opts[hjMethodOpt]; ok {
+		h.method = v.(string)
+	}
+	// HEAD responses don't have body, there wouldn't be JSON to evaluate
+	switch h.method {
+	case http.MethodGet, http.MethodPost, http.MethodPut:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", hjMethodOpt)
+	}
+
+	if v, ok := opts[hjHeadersOpt]; ok {
+		for k, hv := range v.(map[interface{}]interface{}) {
+			h.headers[k.(string)] = hv.(string)
+		}
+	}
+
+	if v, ok := opts[hjBodyOpt]; ok {
+		h.body = v.(string)
+	}
+
+	// Compile the expression, this way we know at creation time if it is wrong
+	expr, ok := opts[hjExpressionOpt].(string)
+	if !ok || expr == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", hjExpressionOpt)
+	}
+	if h.expression, err = jmespath.Compile(expr); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", hjExpressionOpt, err)
+	}
+
+	// Auth
+	if v, ok := opts[hjUsernameOpt]; ok {
+		h.username = v.(string)
+	}
+	if v, ok := opts[hjPasswordOpt]; ok {
+		h.password = v.(string)
+	}
+	if v, ok := opts[hjBearerTokenOpt]; ok {
+		h.bearerToken = v.(string)
+	}
+	if h.username != "" && h.bearerToken != "" {
+		return nil, fmt.Errorf("%s and %s configuration opts can't be used at the same time", hjUsernameOpt, hjBearerTokenOpt)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[hjCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[hjInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", hjCAFileOpt, err)
+	}
+
+	timeout := hjDefaultTimeout
+	if v, ok := opts[hjTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", hjTimeoutOpt, err)
+		}
+	}
+	h.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	h.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       httpJSONRegName,
+	})
+
+	return h, nil
+}
+
+// Gather calls the endpoint and returns the quantity extracted from the response
+func (h *HTTPJSON) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	req, err := http.NewRequest(h.method, h.url, bytes.NewBufferString(h.body))
+	if err != nil {
+		return q, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	switch {
+	case h.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+h.bearerToken)
+	case h.username != "":
+		req.SetBasicAuth(h.username, h.password)
+	}
+
+	h.log.Debugf("Calling %s", h.url)
+	resp, err := h.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return q, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return q, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return q, fmt.Errorf("%s returned %d status code", h.url, resp.StatusCode)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return q, fmt.Errorf("error decoding response: %s", err)
+	}
+
+	res, err := h.expression.Search(data)
+	if err != nil {
+		return q, err
+	}
+
+	// Get the number from the result, allow numbers as strings
+	var v float64
+	switch r := res.(type) {
+	case float64:
+		v = r
+	case string:
+		if v, err = strconv.ParseFloat(r, 64); err != nil {
+			return q, fmt.Errorf("expression result is not a number: %s", r)
+		}
+	case nil:
+		return q, fmt.Errorf("expression didn't match any value")
+	default:
+		return q, fmt.Errorf("expression result is not a number: %v", r)
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return q, fmt.Errorf("expression result is not a valid number: %v", v)
+	}
+	q.Q = utilmath.RoundInt64(v)
+
+	h.log.Debugf("Retrieved http json input: %s", q)
+
+	return q, nil
+}
@@ -0,0 +1,242 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestHTTPJSONCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantMethod string
+		wantError  bool
+	}{
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.size",
+			},
+			wantMethod: http.MethodGet,
+		},
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:         "https://render-api.prod.themotion.lan/api/v1/stats",
+				hjMethodOpt:      http.MethodPost,
+				hjHeadersOpt:     map[interface{}]interface{}{"X-Team": "render"},
+				hjBodyOpt:        `{"queue": "jobs"}`,
+				hjExpressionOpt:  "queues[?name=='jobs'] | [0].pending",
+				hjTimeoutOpt:     "2s",
+				hjBearerTokenOpt: "token",
+			},
+			wantMethod: http.MethodPost,
+		},
+		// Missing url
+		{
+			opts: map[string]interface{}{
+				hjExpressionOpt: "queue.size",
+			},
+			wantError: true,
+		},
+		// Wrong url
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "stats",
+				hjExpressionOpt: "queue.size",
+			},
+			wantError: true,
+		},
+		// Missing expression
+		{
+			opts: map[string]interface{}{
+				hjURLOpt: "http://127.0.0.1:8080/stats",
+			},
+			wantError: true,
+		},
+		// Wrong expression
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.[size",
+			},
+			wantError: true,
+		},
+		// Wrong method
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.size",
+				hjMethodOpt:     "FETCH",
+			},
+			wantError: true,
+		},
+		// Method without response body
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.size",
+				hjMethodOpt:     http.MethodHead,
+			},
+			wantError: true,
+		},
+		// Basic and bearer auth at the same time
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:         "http://127.0.0.1:8080/stats",
+				hjExpressionOpt:  "queue.size",
+				hjUsernameOpt:    "ladder",
+				hjBearerTokenOpt: "token",
+			},
+			wantError: true,
+		},
+		// Wrong CA file
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.size",
+				hjCAFileOpt:     "/tmp/does/not/exist.crt",
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				hjURLOpt:        "http://127.0.0.1:8080/stats",
+				hjExpressionOpt: "queue.size",
+				hjTimeoutOpt:    "2",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		h, err := NewHTTPJSON(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if h.method != test.wantMethod {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantMethod, h.method)
+		}
+	}
+}
+
+func TestHTTPJSONGather(t *testing.T) {
+	tests := []struct {
+		response   string
+		code       int
+		expression string
+
+		wantValue int64
+		wantError bool
+	}{
+		{`{"queue": {"size": 42}}`, http.StatusOK, "queue.size", 42, false},
+		{`{"queue": {"size": 41.6}}`, http.StatusOK, "queue.size", 42, false},
+		{`{"queue": {"size": "17"}}`, http.StatusOK, "queue.size", 17, false},
+		{`{"queues": [{"name": "jobs", "pending": 10}, {"name": "renders", "pending": 30}]}`, http.StatusOK, "queues[?name=='renders'] | [0].pending", 30, false},
+		{`{"queues": [{"pending": 10}, {"pending": 30}]}`, http.StatusOK, "sum(queues[].pending)", 40, false},
+		{`[1, 2, 3]`, http.StatusAccepted, "length(@)", 3, false},
+		// Missing values are bad
+		{`{"queue": {}}`, http.StatusOK, "queue.size", 0, true},
+		// Not numbers are bad
+		{`{"queue": {"size": "big"}}`, http.StatusOK, "queue.size", 0, true},
+		{`{"queue": {"size": [1, 2]}}`, http.StatusOK, "queue.size", 0, true},
+		{`{"queue": {"size": true}}`, http.StatusOK, "queue.size", 0, true},
+		// Bad responses are bad
+		{`{"queue": {"size": 42}`, http.StatusOK, "queue.size", 0, true},
+		{`{"queue": {"size": 42}}`, http.StatusInternalServerError, "queue.size", 0, true},
+	}
+
+	for _, test := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Check the request is correct
+			b, _ := ioutil.ReadAll(r.Body)
+			if r.Method != http.MethodPost ||
+				r.URL.Path != "/stats" ||
+				r.Header.Get("X-Team") != "render" ||
+				r.Header.Get("Authorization") != "Bearer token" ||
+				string(b) != `{"queue": "jobs"}` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(test.code)
+			fmt.Fprint(w, test.response)
+		}))
+
+		h, err := NewHTTPJSON(context.TODO(), map[string]interface{}{
+			hjURLOpt:         ts.URL + "/stats",
+			hjMethodOpt:      http.MethodPost,
+			hjHeadersOpt:     map[interface{}]interface{}{"X-Team": "render"},
+			hjBodyOpt:        `{"queue": "jobs"}`,
+			hjExpressionOpt:  test.expression,
+			hjBearerTokenOpt: "token",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		h.log = log.New()
+
+		q, err := h.Gather(context.TODO())
+		ts.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantValue {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantValue, q.Q)
+		}
+	}
+}
+
+func TestHTTPJSONGatherBasicAuth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "ladder" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"size": 7}`)
+	}))
+	defer ts.Close()
+
+	h, err := NewHTTPJSON(context.TODO(), map[string]interface{}{
+		hjURLOpt:        ts.URL,
+		hjExpressionOpt: "size",
+		hjUsernameOpt:   "ladder",
+		hjPasswordOpt:   "secret",
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+
+	q, err := h.Gather(context.TODO())
+	if err != nil {
+		t.Fatalf("Gather shouldn't give error: %v", err)
+	}
+	if q.Q != 7 {
+		t.Errorf("Wrong gathering retrieved value, want: %v; got %v", 7, q.Q)
+	}
+}
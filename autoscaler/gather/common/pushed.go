@@ -0,0 +1,119 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const (
+	// Opts
+	pvTTLOpt = "ttl"
+
+	// the name
+	pushedValueRegName = "pushed_value"
+)
+
+// PushedValue represents an object that returns the last quantity pushed from outside
+// (through the API), the quantity can expire after a TTL
+type PushedValue struct {
+	ttl time.Duration // The time the pushed quantity is valid, 0 means forever
+
+	q        types.Quantity // The last pushed quantity
+	pushed   bool           // A quantity has been pushed
+	pushedAt time.Time      // When the last quantity was pushed
+	mu       sync.Mutex
+
+	now func() time.Time // Clock, used to get the current time
+	log *log.Log         // custom logger
+}
+
+type pushedValueCreator struct{}
+
+func (p *pushedValueCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewPushedValue(ctx, opts)
+}
+
+// Autoregister on gatherers creator
+func init() {
+	gather.Register(pushedValueRegName, &pushedValueCreator{})
+}
+
+// NewPushedValue creates a pushed value gatherer
+func NewPushedValue(ctx context.Context, opts map[string]interface{}) (p *PushedValue, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	p = &PushedValue{
+		now: time.Now,
+	}
+
+	if v, ok := opts[pvTTLOpt]; ok {
+		if p.ttl, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", pvTTLOpt, err)
+		}
+		if p.ttl < 0 {
+			return nil, fmt.Errorf("%s can't be negative", pvTTLOpt)
+		}
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	p.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       pushedValueRegName,
+	})
+
+	return
+}
+
+// Push sets the quantity that will be returned when gathering
+func (p *PushedValue) Push(q types.Quantity) error {
+	if q.Q < 0 {
+		return fmt.Errorf("pushed quantity can't be negative: %s", q)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.q = q
+	p.pushed = true
+	p.pushedAt = p.now()
+	p.log.Debugf("Pushed value input: %s", q)
+
+	return nil
+}
+
+// Gather returns the last pushed quantity, it will error if there isn't a quantity or it
+// expired
+func (p *PushedValue) Gather(_ context.Context) (types.Quantity, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.pushed {
+		return types.Quantity{}, fmt.Errorf("a value hasn't been pushed yet")
+	}
+
+	if p.ttl > 0 {
+		if age := p.now().Sub(p.pushedAt); age > p.ttl {
+			return types.Quantity{}, fmt.Errorf("pushed value expired %s ago", age-p.ttl)
+		}
+	}
+
+	p.log.Debugf("Retrieved pushed value input: %s", p.q)
+
+	return p.q, nil
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/types"
+)
+
+func TestPushedValueCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantTTL   time.Duration
+		wantError bool
+	}{
+		{opts: map[string]interface{}{}, wantTTL: 0},
+		{opts: map[string]interface{}{pvTTLOpt: "5m"}, wantTTL: 5 * time.Minute},
+		{opts: map[string]interface{}{pvTTLOpt: "5"}, wantError: true},
+		{opts: map[string]interface{}{pvTTLOpt: "-5m"}, wantError: true},
+		{opts: map[string]interface{}{pvTTLOpt: 300}, wantError: true},
+	}
+
+	for _, test := range tests {
+		p, err := NewPushedValue(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if p.ttl != test.wantTTL {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantTTL, p.ttl)
+		}
+	}
+}
+
+func TestPushedValueGather(t *testing.T) {
+	// Pushed value needs to be pushable
+	var _ gather.Pusher = &PushedValue{}
+
+	now := time.Now()
+
+	tests := []struct {
+		ttl    string
+		pushes []int64
+		age    time.Duration // Time since the last push when gathering
+
+		wantQ         int64
+		wantPushError bool
+		wantError     bool
+	}{
+		{"0s", []int64{10}, time.Hour, 10, false, false},
+		{"0s", []int64{10, 20, 5}, 0, 5, false, false},
+		{"5m", []int64{10, 20}, 4 * time.Minute, 20, false, false},
+		{"5m", []int64{10, 20}, 5 * time.Minute, 20, false, false},
+		// Expired
+		{"5m", []int64{10, 20}, 6 * time.Minute, 0, false, true},
+		// Not pushed
+		{"0s", []int64{}, 0, 0, false, true},
+		// Negative pushes are ignored
+		{"0s", []int64{10, -1}, 0, 10, true, false},
+	}
+
+	for _, test := range tests {
+		p, err := NewPushedValue(context.TODO(), map[string]interface{}{pvTTLOpt: test.ttl})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		p.now = func() time.Time { return now }
+
+		var pushErr error
+		for _, q := range test.pushes {
+			if err := p.Push(types.Quantity{Q: q}); err != nil {
+				pushErr = err
+			}
+		}
+		if test.wantPushError != (pushErr != nil) {
+			t.Errorf("\n- %+v\n  Wrong push error: %v", test, pushErr)
+		}
+
+		p.now = func() time.Time { return now.Add(test.age) }
+		q, err := p.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const (
+	// Opts
+	schTimezoneOpt = "timezone"
+	schDefaultOpt  = "default"
+	schWindowsOpt  = "windows"
+
+	// Window opts
+	schWindowCronOpt     = "cron"
+	schWindowWeekdaysOpt = "weekdays"
+	schWindowStartOpt    = "start"
+	schWindowEndOpt      = "end"
+	schWindowQuantityOpt = "quantity"
+
+	// Time of the day format of the windows
+	schClockFormat = "15:04"
+
+	// id name
+	scheduleRegName = "schedule"
+)
+
+var schWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// schWindow is a time window of the schedule, it can be a cron expression (the window is
+// active while the current minute matches the expression) or a time range of the day on
+// some weekdays
+type schWindow struct {
+	cron     *cron.SpecSchedule
+	weekdays map[time.Weekday]bool // Empty means all days
+	start    time.Duration         // Since the beginning of the day
+	end      time.Duration         // Since the beginning of the day, lower than start crosses midnight
+	quantity types.Quantity
+}
+
+// matches returns true if the time is inside the window
+func (w *schWindow) matches(t time.Time) bool {
+	if w.cron != nil {
+		m := t.Truncate(time.Minute)
+		return w.cron.Next(m.Add(-time.Second)).Equal(m)
+	}
+
+	// Wall clock, this way DST changes don't move the windows
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	day := t.Weekday()
+
+	switch {
+	case w.start < w.end:
+		if sinceMidnight < w.start || sinceMidnight >= w.end {
+			return false
+		}
+	case w.start > w.end:
+		// The window crosses midnight, after midnight the window started the previous day
+		switch {
+		case sinceMidnight >= w.start:
+		case sinceMidnight < w.end:
+			day = (day + 6) % 7
+		default:
+			return false
+		}
+	}
+
+	return len(w.weekdays) == 0 || w.weekdays[day]
+}
+
+// newSchWindow creates a schedule window from the window configuration
+func newSchWindow(opts map[interface{}]interface{}, loc *time.Location) (*schWindow, error) {
+	w := &schWindow{weekdays: map[time.Weekday]bool{}}
+
+	v, ok := opts[schWindowQuantityOpt]
+	if !ok {
+		return nil, fmt.Errorf("%s window opt is required", schWindowQuantityOpt)
+	}
+	w.quantity = types.Quantity{Q: types.I2Int64(v)}
+
+	if v, ok := opts[schWindowCronOpt]; ok {
+		for _, o := range []string{schWindowWeekdaysOpt, schWindowStartOpt, schWindowEndOpt} {
+			if _, ok := opts[o]; ok {
+				return nil, fmt.Errorf("%s and %s window opts can't be used at the same time", schWindowCronOpt, o)
+			}
+		}
+		s, err := cron.ParseStandard(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%s window opt is wrong: %s", schWindowCronOpt, err)
+		}
+		spec, ok := s.(*cron.SpecSchedule)
+		if !ok {
+			return nil, fmt.Errorf("%s window opt is wrong: only cron expressions are allowed", schWindowCronOpt)
+		}
+		// Use the schedule timezone if the expression doesn't set one
+		if spec.Location == time.Local {
+			spec.Location = loc
+		}
+		w.cron = spec
+		return w, nil
+	}
+
+	if v, ok := opts[schWindowWeekdaysOpt]; ok {
+		for _, d := range v.([]interface{}) {
+			wd, ok := schWeekdays[strings.ToLower(d.(string))]
+			if !ok {
+				return nil, fmt.Errorf("%s window opt is wrong: %s is not a weekday", schWindowWeekdaysOpt, d)
+			}
+			w.weekdays[wd] = true
+		}
+	}
+
+	// The range of the day, the whole day by default
+	start, okS := opts[schWindowStartOpt]
+	end, okE := opts[schWindowEndOpt]
+	if okS != okE {
+		return nil, fmt.Errorf("%s and %s window opts are required together", schWindowStartOpt, schWindowEndOpt)
+	}
+	if okS {
+		var err error
+		if w.start, err = parseSchClock(start.(string)); err != nil {
+			return nil, fmt.Errorf("%s window opt is wrong: %s", schWindowStartOpt, err)
+		}
+		if w.end, err = parseSchClock(end.(string)); err != nil {
+			return nil, fmt.Errorf("%s window opt is wrong: %s", schWindowEndOpt, err)
+		}
+		if w.start == w.end {
+			return nil, fmt.Errorf("%s and %s window opts can't be the same", schWindowStartOpt, schWindowEndOpt)
+		}
+	} else {
+		w.end = 24 * time.Hour
+	}
+
+	return w, nil
+}
+
+// parseSchClock parses a time of the day (HH:MM) and returns the duration since the
+// beginning of the day, 24:00 is the end of the day
+func parseSchClock(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse(schClockFormat, s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Schedule will return the quantity of the first time window of the schedule that is
+// active, if there isn't an active window then the default quantity will be returned
+type Schedule struct {
+	location *time.Location   // The timezone of the windows
+	windows  []*schWindow     // The windows, in order of priority
+	defaultQ *types.Quantity  // The quantity when the windows aren't active, if missing it will error
+	now      func() time.Time // Clock, used to get the current time
+
+	log *log.Log // custom logger
+}
+
+type scheduleCreator struct{}
+
+func (s *scheduleCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewSchedule(ctx, opts)
+}
+
+// Autoregister on gatherers creator
+func init() {
+	gather.Register(scheduleRegName, &scheduleCreator{})
+}
+
+// NewSchedule creates a schedule Gatherer
+func NewSchedule(ctx context.Context, opts map[string]interface{}) (s *Schedule, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	s = &Schedule{
+		location: time.UTC,
+		now:      time.Now,
+	}
+
+	if v, ok := opts[schTimezoneOpt]; ok {
+		if s.location, err = time.LoadLocation(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", schTimezoneOpt, err)
+		}
+	}
+
+	if v, ok := opts[schDefaultOpt]; ok {
+		s.defaultQ = &types.Quantity{Q: types.I2Int64(v)}
+	}
+
+	wsI, _ := opts[schWindowsOpt].([]interface{})
+	if len(wsI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", schWindowsOpt)
+	}
+	for i, wI := range wsI {
+		w, err := newSchWindow(wI.(map[interface{}]interface{}), s.location)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt %d window is wrong: %s", schWindowsOpt, i, err)
+		}
+		s.windows = append(s.windows, w)
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	s.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       scheduleRegName,
+	})
+
+	return s, nil
+}
+
+// Gather returns the quantity of the active window
+func (s *Schedule) Gather(_ context.Context) (types.Quantity, error) {
+	now := s.now().In(s.location)
+
+	for i, w := range s.windows {
+		if w.matches(now) {
+			s.log.Debugf("Schedule window %d active at %s: %s", i, now, w.quantity)
+			return w.quantity, nil
+		}
+	}
+
+	if s.defaultQ == nil {
+		return types.Quantity{}, fmt.Errorf("there isn't an active window at %s and default quantity is missing", now)
+	}
+
+	s.log.Debugf("Schedule default active at %s: %s", now, *s.defaultQ)
+	return *s.defaultQ, nil
+}
@@ -0,0 +1,249 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestScheduleCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantWindows int
+		wantError   bool
+	}{
+		{
+			opts: map[string]interface{}{
+				schTimezoneOpt: "Europe/Madrid",
+				schDefaultOpt:  6,
+				schWindowsOpt: []interface{}{
+					map[interface{}]interface{}{
+						schWindowWeekdaysOpt: []interface{}{"mon", "tue", "wed", "thu", "fri"},
+						schWindowStartOpt:    "08:00",
+						schWindowEndOpt:      "20:00",
+						schWindowQuantityOpt: 20,
+					},
+					map[interface{}]interface{}{
+						schWindowWeekdaysOpt: []interface{}{"Sat", "Sun"},
+						schWindowQuantityOpt: 4,
+					},
+				},
+			},
+			wantWindows: 2,
+		},
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{
+					map[interface{}]interface{}{schWindowCronOpt: "* 8-19 * * MON-FRI", schWindowQuantityOpt: 20},
+					map[interface{}]interface{}{schWindowStartOpt: "22:00", schWindowEndOpt: "02:00", schWindowQuantityOpt: 2},
+					map[interface{}]interface{}{schWindowStartOpt: "20:00", schWindowEndOpt: "24:00", schWindowQuantityOpt: 3},
+				},
+			},
+			wantWindows: 3,
+		},
+		// Missing windows
+		{opts: map[string]interface{}{schDefaultOpt: 6}, wantError: true},
+		{opts: map[string]interface{}{schDefaultOpt: 6, schWindowsOpt: []interface{}{}}, wantError: true},
+		// Wrong timezone
+		{
+			opts: map[string]interface{}{
+				schTimezoneOpt: "Europe/Nowhere",
+				schWindowsOpt:  []interface{}{map[interface{}]interface{}{schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Wrong default
+		{
+			opts: map[string]interface{}{
+				schDefaultOpt: "6",
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Missing window quantity
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowWeekdaysOpt: []interface{}{"mon"}}},
+			},
+			wantError: true,
+		},
+		// Wrong weekday
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowWeekdaysOpt: []interface{}{"monday"}, schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Missing window end
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowStartOpt: "08:00", schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Wrong window times
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowStartOpt: "8h", schWindowEndOpt: "20:00", schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowStartOpt: "08:00", schWindowEndOpt: "08:00", schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Wrong cron
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowCronOpt: "* 8-25 * * *", schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowCronOpt: "@every 1h", schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+		// Cron and time range
+		{
+			opts: map[string]interface{}{
+				schWindowsOpt: []interface{}{map[interface{}]interface{}{schWindowCronOpt: "* 8-19 * * *", schWindowWeekdaysOpt: []interface{}{"mon"}, schWindowQuantityOpt: 1}},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		s, err := NewSchedule(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(s.windows) != test.wantWindows {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantWindows, len(s.windows))
+		}
+	}
+}
+
+func TestScheduleGather(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Fatalf("Error loading timezone: %v", err)
+	}
+
+	businessHours := map[string]interface{}{
+		schTimezoneOpt: "Europe/Madrid",
+		schDefaultOpt:  6,
+		schWindowsOpt: []interface{}{
+			map[interface{}]interface{}{
+				schWindowWeekdaysOpt: []interface{}{"mon", "tue", "wed", "thu", "fri"},
+				schWindowStartOpt:    "08:00",
+				schWindowEndOpt:      "20:00",
+				schWindowQuantityOpt: 20,
+			},
+			map[interface{}]interface{}{
+				schWindowWeekdaysOpt: []interface{}{"sat", "sun"},
+				schWindowQuantityOpt: 4,
+			},
+		},
+	}
+
+	nightly := map[string]interface{}{
+		schTimezoneOpt: "Europe/Madrid",
+		schWindowsOpt: []interface{}{
+			// Fridays night batch, crosses midnight
+			map[interface{}]interface{}{
+				schWindowWeekdaysOpt: []interface{}{"fri"},
+				schWindowStartOpt:    "22:00",
+				schWindowEndOpt:      "02:00",
+				schWindowQuantityOpt: 50,
+			},
+			map[interface{}]interface{}{
+				schWindowCronOpt:     "0-29 * * * *",
+				schWindowQuantityOpt: 10,
+			},
+			map[interface{}]interface{}{
+				schWindowCronOpt:     "CRON_TZ=UTC * 12 * * *",
+				schWindowQuantityOpt: 30,
+			},
+		},
+	}
+
+	tests := []struct {
+		opts map[string]interface{}
+		now  time.Time
+
+		wantQ     int64
+		wantError bool
+	}{
+		// Monday 2024-01-15
+		{businessHours, time.Date(2024, 1, 15, 8, 0, 0, 0, madrid), 20, false},
+		{businessHours, time.Date(2024, 1, 15, 19, 59, 59, 0, madrid), 20, false},
+		{businessHours, time.Date(2024, 1, 15, 20, 0, 0, 0, madrid), 6, false},
+		{businessHours, time.Date(2024, 1, 15, 7, 59, 0, 0, madrid), 6, false},
+		// Clock on other timezone: 07:30 UTC is 08:30 on Madrid
+		{businessHours, time.Date(2024, 1, 15, 7, 30, 0, 0, time.UTC), 20, false},
+		// Saturday 2024-01-20 and Sunday 2024-01-21
+		{businessHours, time.Date(2024, 1, 20, 12, 0, 0, 0, madrid), 4, false},
+		{businessHours, time.Date(2024, 1, 21, 23, 59, 0, 0, madrid), 4, false},
+		// Sunday 23:30 UTC is Monday on Madrid
+		{businessHours, time.Date(2024, 1, 21, 23, 30, 0, 0, time.UTC), 6, false},
+		// DST day on Madrid (2024-03-31 is sunday, 2024-04-01 monday)
+		{businessHours, time.Date(2024, 4, 1, 8, 0, 0, 0, madrid), 20, false},
+		{businessHours, time.Date(2024, 3, 29, 19, 30, 0, 0, madrid), 20, false},
+
+		// Friday 2024-01-19 night and saturday morning
+		{nightly, time.Date(2024, 1, 19, 22, 45, 0, 0, madrid), 50, false},
+		{nightly, time.Date(2024, 1, 20, 1, 59, 0, 0, madrid), 50, false},
+		{nightly, time.Date(2024, 1, 20, 2, 0, 0, 0, madrid), 10, false},
+		// Thursday night isn't friday night
+		{nightly, time.Date(2024, 1, 19, 1, 0, 0, 0, madrid), 10, false},
+		{nightly, time.Date(2024, 1, 18, 22, 29, 59, 0, madrid), 10, false},
+		{nightly, time.Date(2024, 1, 18, 22, 30, 0, 0, madrid), 0, true},
+		// Cron on UTC: 12:45 UTC
+		{nightly, time.Date(2024, 1, 18, 13, 45, 0, 0, madrid), 30, false},
+		{nightly, time.Date(2024, 1, 18, 12, 45, 0, 0, madrid), 0, true},
+	}
+
+	for _, test := range tests {
+		s, err := NewSchedule(context.TODO(), test.opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		s.log = log.New()
+		now := test.now
+		s.now = func() time.Time { return now }
+
+		q, err := s.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %s\n  Wrong gathering retrieved value, want: %v; got %v", test.now, test.wantQ, q.Q)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	esAddressesOpt          = "addresses"
+	esIndexOpt              = "index"
+	esQueryOpt              = "query"
+	esAggregationOpt        = "aggregation"
+	esTimeoutOpt            = "timeout"
+	esUsernameOpt           = "username"
+	esPasswordOpt           = "password"
+	esCAFileOpt             = "ca_file"
+	esInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// The name of the aggregation on the search requests
+	esAggregationName = "ladder"
+
+	// Defaults
+	esDefaultTimeout = 10 * time.Second
+
+	// the name
+	esRegName = "elasticsearch_count"
+)
+
+// esCountResponse is the response of the count API
+type esCountResponse struct {
+	Count *float64 `json:"count"`
+}
+
+// esSearchResponse is the response of the search API with the aggregation
+type esSearchResponse struct {
+	Aggregations map[string]struct {
+		Value    *float64 `json:"value"`
+		DocCount *float64 `json:"doc_count"`
+	} `json:"aggregations"`
+}
+
+// ElasticsearchCount represents an object for gathering inputs counting the documents
+// of an Elasticsearch (or OpenSearch) index or with an aggregation
+type ElasticsearchCount struct {
+	addresses   []string
+	index       string          // The index pattern
+	query       json.RawMessage // The query DSL that filters the documents
+	aggregation json.RawMessage // The aggregation whose value will be returned instead of the count
+	username    string
+	password    string
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+// elasticsearchCountCreator creates the elasticsearch count gatherer creator
+type elasticsearchCountCreator struct{}
+
+func (e *elasticsearchCountCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewElasticsearchCount(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(esRegName, &elasticsearchCountCreator{})
+}
+
+// NewElasticsearchCount creates an Elasticsearch count gatherer
+func NewElasticsearchCount(ctx context.Context, opts map[string]interface{}) (e *ElasticsearchCount, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	e = &ElasticsearchCount{}
+
+	var ok bool
+
+	// interfaces and arrays stuff conversion
+	for _, a := range opts[esAddressesOpt].([]interface{}) {
+		addr := strings.TrimRight(a.(string), "/")
+		u, err := url.ParseRequestURI(addr)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", esAddressesOpt, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s is not an HTTP address", esAddressesOpt, addr)
+		}
+		e.addresses = append(e.addresses, addr)
+	}
+	if len(e.addresses) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", esAddressesOpt)
+	}
+
+	if e.index, ok = opts[esIndexOpt].(string); !ok || e.index == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", esIndexOpt)
+	}
+
+	// Check the JSON is valid at creation time
+	if v, ok := opts[esQueryOpt]; ok {
+		var js interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &js); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is not valid JSON: %s", esQueryOpt, err)
+		}
+		e.query = json.RawMessage(v.(string))
+	}
+	if v, ok := opts[esAggregationOpt]; ok {
+		var js interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &js); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is not valid JSON: %s", esAggregationOpt, err)
+		}
+		e.aggregation = json.RawMessage(v.(string))
+	}
+
+	// Auth
+	if v, ok := opts[esUsernameOpt]; ok {
+		e.username = v.(string)
+	}
+	if v, ok := opts[esPasswordOpt]; ok {
+		e.password = v.(string)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[esCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[esInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", esCAFileOpt, err)
+	}
+
+	timeout := esDefaultTimeout
+	if v, ok := opts[esTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", esTimeoutOpt, err)
+		}
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", esTimeoutOpt)
+	}
+	e.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	e.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       esRegName,
+	})
+
+	return
+}
+
+// request returns the API path and the body of the request
+func (e *ElasticsearchCount) request() (string, []byte, error) {
+	body := map[string]interface{}{}
+	if e.query != nil {
+		body["query"] = e.query
+	}
+
+	// Count API doesn't support aggregations, use search API without hits
+	if e.aggregation == nil {
+		b, err := json.Marshal(body)
+		return "_count", b, err
+	}
+	body["size"] = 0
+	body["aggs"] = map[string]json.RawMessage{esAggregationName: e.aggregation}
+	b, err := json.Marshal(body)
+	return "_search", b, err
+}
+
+// call makes the request to an Elasticsearch node and returns the value
+func (e *ElasticsearchCount) call(ctx context.Context, address, api string, body []byte) (float64, error) {
+	u := fmt.Sprintf("%s/%s/%s", address, url.PathEscape(e.index), api)
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+
+	resp, err := e.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return 0, fmt.Errorf("%s returned %d status code: %s", u, resp.StatusCode, b)
+	}
+
+	if e.aggregation == nil {
+		r := esCountResponse{}
+		if err := json.Unmarshal(b, &r); err != nil {
+			return 0, fmt.Errorf("error decoding response: %s", err)
+		}
+		if r.Count == nil {
+			return 0, fmt.Errorf("missing count on response")
+		}
+		return *r.Count, nil
+	}
+
+	r := esSearchResponse{}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return 0, fmt.Errorf("error decoding response: %s", err)
+	}
+	agg, ok := r.Aggregations[esAggregationName]
+	if !ok {
+		return 0, fmt.Errorf("missing aggregation on response")
+	}
+	// Metric aggregations have value, bucket single aggregations have doc count
+	switch {
+	case agg.Value != nil:
+		return *agg.Value, nil
+	case agg.DocCount != nil:
+		return *agg.DocCount, nil
+	}
+	return 0, fmt.Errorf("aggregation doesn't have value, this means no metric")
+}
+
+// Gather counts the documents or gets the aggregation value, the nodes will be
+// used in order until one of them succeeds
+func (e *ElasticsearchCount) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	api, body, err := e.request()
+	if err != nil {
+		return q, err
+	}
+
+	errs := []string{}
+	for i, a := range e.addresses {
+		v, err := e.call(ctx, a, api, body)
+		if err != nil {
+			errs = append(errs, err.Error())
+			e.log.Warningf("elasticsearch '%d' node failed: %v", i, err)
+			continue
+		}
+
+		q.Q = utilmath.RoundInt64(v)
+		e.log.Debugf("Retrieved elasticsearch count input: %s", q)
+		return q, nil
+	}
+
+	return q, errors.New(strings.Join(errs, "; "))
+}
@@ -0,0 +1,320 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestElasticsearchCountCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantAddresses int
+		wantError     bool
+	}{
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{"http://127.0.0.1:9200"},
+				esIndexOpt:     "logs-*",
+			},
+			wantAddresses: 1,
+		},
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt:   []interface{}{"http://es1:9200/", "http://es2:9200"},
+				esIndexOpt:       "logs-*",
+				esQueryOpt:       `{"term": {"status": "pending"}}`,
+				esAggregationOpt: `{"sum": {"field": "size"}}`,
+				esTimeoutOpt:     "2s",
+				esUsernameOpt:    "ladder",
+				esPasswordOpt:    "secret",
+			},
+			wantAddresses: 2,
+		},
+		// Missing addresses
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{},
+				esIndexOpt:     "logs-*",
+			},
+			wantError: true,
+		},
+		// Wrong addresses
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{"es1:9200"},
+				esIndexOpt:     "logs-*",
+			},
+			wantError: true,
+		},
+		// Missing index
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{"http://127.0.0.1:9200"},
+			},
+			wantError: true,
+		},
+		// Wrong query
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{"http://127.0.0.1:9200"},
+				esIndexOpt:     "logs-*",
+				esQueryOpt:     `{"term": {"status": "pending"}`,
+			},
+			wantError: true,
+		},
+		// Wrong aggregation
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt:   []interface{}{"http://127.0.0.1:9200"},
+				esIndexOpt:       "logs-*",
+				esAggregationOpt: `sum`,
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				esAddressesOpt: []interface{}{"http://127.0.0.1:9200"},
+				esIndexOpt:     "logs-*",
+				esTimeoutOpt:   "0s",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		e, err := NewElasticsearchCount(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(e.addresses) != test.wantAddresses {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantAddresses, len(e.addresses))
+		}
+	}
+}
+
+// fakeElasticsearch returns an Elasticsearch like server that responds with the response
+// to the expected API requests
+func fakeElasticsearch(t *testing.T, wantPath string, wantBody map[string]interface{}, status int, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != wantPath {
+			t.Errorf("Wrong request, want: POST %s; got: %s %s", wantPath, r.Method, r.URL.Path)
+		}
+		if u, p, _ := r.BasicAuth(); u != "ladder" || p != "secret" {
+			t.Errorf("Wrong basic auth, got: %s:%s", u, p)
+		}
+
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBody := map[string]interface{}{}
+		if err := json.Unmarshal(b, &gotBody); err != nil {
+			t.Errorf("Wrong request body: %s", err)
+		}
+		if fmt.Sprint(gotBody) != fmt.Sprint(wantBody) {
+			t.Errorf("Wrong request body, want: %v; got: %v", wantBody, gotBody)
+		}
+
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	}))
+}
+
+func TestElasticsearchCountGather(t *testing.T) {
+	pendingQuery := map[string]interface{}{"term": map[string]interface{}{"status": "pending"}}
+	sumAgg := map[string]interface{}{"sum": map[string]interface{}{"field": "size"}}
+
+	tests := []struct {
+		query       string
+		aggregation string
+
+		wantPath  string
+		wantBody  map[string]interface{}
+		status    int
+		response  string
+		wantQ     int64
+		wantError bool
+	}{
+		{
+			wantPath: "/logs-*/_count",
+			wantBody: map[string]interface{}{},
+			status:   http.StatusOK,
+			response: `{"count": 1250, "_shards": {"total": 5, "successful": 5, "skipped": 0, "failed": 0}}`,
+			wantQ:    1250,
+		},
+		{
+			query:    `{"term": {"status": "pending"}}`,
+			wantPath: "/logs-*/_count",
+			wantBody: map[string]interface{}{"query": pendingQuery},
+			status:   http.StatusOK,
+			response: `{"count": 42, "_shards": {"total": 5, "successful": 5, "skipped": 0, "failed": 0}}`,
+			wantQ:    42,
+		},
+		{
+			query:       `{"term": {"status": "pending"}}`,
+			aggregation: `{"sum": {"field": "size"}}`,
+			wantPath:    "/logs-*/_search",
+			wantBody:    map[string]interface{}{"query": pendingQuery, "size": float64(0), "aggs": map[string]interface{}{"ladder": sumAgg}},
+			status:      http.StatusOK,
+			response:    `{"hits": {"total": 42, "hits": []}, "aggregations": {"ladder": {"value": 1234.6}}}`,
+			wantQ:       1235,
+		},
+		{
+			aggregation: `{"filter": {"term": {"status": "pending"}}}`,
+			wantPath:    "/logs-*/_search",
+			wantBody:    map[string]interface{}{"size": float64(0), "aggs": map[string]interface{}{"ladder": map[string]interface{}{"filter": pendingQuery}}},
+			status:      http.StatusOK,
+			response:    `{"hits": {"total": 1250, "hits": []}, "aggregations": {"ladder": {"doc_count": 42}}}`,
+			wantQ:       42,
+		},
+		// Aggregations without value are errors
+		{
+			aggregation: `{"avg": {"field": "size"}}`,
+			wantPath:    "/logs-*/_search",
+			wantBody:    map[string]interface{}{"size": float64(0), "aggs": map[string]interface{}{"ladder": map[string]interface{}{"avg": map[string]interface{}{"field": "size"}}}},
+			status:      http.StatusOK,
+			response:    `{"hits": {"total": 0, "hits": []}, "aggregations": {"ladder": {"value": null}}}`,
+			wantError:   true,
+		},
+		// Bad responses
+		{
+			wantPath:  "/logs-*/_count",
+			wantBody:  map[string]interface{}{},
+			status:    http.StatusNotFound,
+			response:  `{"error": {"type": "index_not_found_exception"}, "status": 404}`,
+			wantError: true,
+		},
+		{
+			wantPath:  "/logs-*/_count",
+			wantBody:  map[string]interface{}{},
+			status:    http.StatusOK,
+			response:  `{"_shards": {"total": 5}}`,
+			wantError: true,
+		},
+		{
+			wantPath:  "/logs-*/_count",
+			wantBody:  map[string]interface{}{},
+			status:    http.StatusOK,
+			response:  `not json`,
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		s := fakeElasticsearch(t, test.wantPath, test.wantBody, test.status, test.response)
+
+		opts := map[string]interface{}{
+			esAddressesOpt: []interface{}{s.URL},
+			esIndexOpt:     "logs-*",
+			esUsernameOpt:  "ladder",
+			esPasswordOpt:  "secret",
+		}
+		if test.query != "" {
+			opts[esQueryOpt] = test.query
+		}
+		if test.aggregation != "" {
+			opts[esAggregationOpt] = test.aggregation
+		}
+
+		e, err := NewElasticsearchCount(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		e.log = log.New()
+
+		q, err := e.Gather(context.TODO())
+		s.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
+
+func TestElasticsearchCountGatherFailover(t *testing.T) {
+	var calls int
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+	good := fakeElasticsearch(t, "/jobs/_count", map[string]interface{}{}, http.StatusOK, `{"count": 7}`)
+	defer good.Close()
+
+	tests := []struct {
+		addresses []interface{}
+
+		wantQ     int64
+		wantCalls int
+		wantError bool
+	}{
+		{[]interface{}{good.URL, bad.URL}, 7, 0, false},
+		{[]interface{}{bad.URL, good.URL}, 7, 1, false},
+		{[]interface{}{bad.URL, "http://127.0.0.1:1", good.URL}, 7, 1, false},
+		{[]interface{}{bad.URL, "http://127.0.0.1:1"}, 0, 1, true},
+	}
+
+	for _, test := range tests {
+		calls = 0
+		e, err := NewElasticsearchCount(context.TODO(), map[string]interface{}{
+			esAddressesOpt: test.addresses,
+			esIndexOpt:     "jobs",
+			esUsernameOpt:  "ladder",
+			esPasswordOpt:  "secret",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		e.log = log.New()
+
+		q, err := e.Gather(context.TODO())
+
+		if calls != test.wantCalls {
+			t.Errorf("\n- %+v\n  Wrong number of calls to failed node, want: %d; got %d", test, test.wantCalls, calls)
+		}
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// SQL drivers
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	sqlDriverOpt  = "driver"
+	sqlDSNOpt     = "dsn"
+	sqlQueryOpt   = "query"
+	sqlTimeoutOpt = "timeout"
+
+	// Defaults
+	sqlDefaultTimeout = 10 * time.Second
+
+	// the name
+	sqlRegName = "sql_query"
+)
+
+// SQLQuery represents an object for gathering inputs running a scalar query on an SQL database
+type SQLQuery struct {
+	driver  string        // The database/sql driver
+	query   string        // The query that returns the quantity
+	timeout time.Duration // The timeout of the query
+
+	db  *sql.DB
+	log *log.Log // custom logger
+}
+
+// sqlQueryCreator creates the sql query gatherer creator
+type sqlQueryCreator struct{}
+
+func (s *sqlQueryCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewSQLQuery(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(sqlRegName, &sqlQueryCreator{})
+}
+
+// NewSQLQuery creates an SQL query gatherer
+func NewSQLQuery(ctx context.Context, opts map[string]interface{}) (s *SQLQuery, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	s = &SQLQuery{
+		timeout: sqlDefaultTimeout,
+	}
+
+	var ok bool
+
+	if s.driver, ok = opts[sqlDriverOpt].(string); !ok || s.driver == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", sqlDriverOpt)
+	}
+
+	// Check the driver is present
+	found := false
+	for _, d := range sql.Drivers() {
+		if d == s.driver {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%s configuration opt is wrong, available drivers: %s", sqlDriverOpt, strings.Join(sql.Drivers(), ", "))
+	}
+
+	dsn, ok := opts[sqlDSNOpt].(string)
+	if !ok || dsn == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", sqlDSNOpt)
+	}
+
+	if s.query, ok = opts[sqlQueryOpt].(string); !ok || s.query == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", sqlQueryOpt)
+	}
+
+	if v, ok := opts[sqlTimeoutOpt]; ok {
+		if s.timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", sqlTimeoutOpt, err)
+		}
+	}
+	if s.timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", sqlTimeoutOpt)
+	}
+
+	// Open doesn't connect to the database, the connection will be made when gathering
+	if s.db, err = sql.Open(s.driver, dsn); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", sqlDSNOpt, err)
+	}
+	s.db.SetMaxOpenConns(1)
+	s.db.SetMaxIdleConns(1)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	s.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       sqlRegName,
+	})
+
+	return
+}
+
+// toInt64 converts the value returned by the driver to an integer
+func toInt64(v interface{}) (int64, error) {
+	switch r := v.(type) {
+	case int64:
+		return r, nil
+	case float64:
+		return utilmath.RoundInt64(r), nil
+	case []byte:
+		return toInt64(string(r))
+	case string:
+		// Numeric types are returned as text by some drivers
+		if i, err := strconv.ParseInt(r, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(r, 64)
+		if err != nil {
+			return 0, fmt.Errorf("query result is not a number: %s", r)
+		}
+		return utilmath.RoundInt64(f), nil
+	case nil:
+		return 0, fmt.Errorf("query result is null")
+	}
+	return 0, fmt.Errorf("query result is not a number: %v", v)
+}
+
+// Gather runs the query and returns its result, the query needs to return
+// one row with one column
+func (s *SQLQuery) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	s.log.Debugf("Running query on %s database", s.driver)
+	rows, err := s.db.QueryContext(ctx, s.query)
+	if err != nil {
+		return q, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return q, err
+	}
+	if len(cols) != 1 {
+		return q, fmt.Errorf("wrong number of columns, should be one, current is: %d", len(cols))
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return q, err
+		}
+		return q, fmt.Errorf("query didn't return any row")
+	}
+
+	var v interface{}
+	if err := rows.Scan(&v); err != nil {
+		return q, err
+	}
+
+	if rows.Next() {
+		return q, fmt.Errorf("query returned more than one row")
+	}
+	if err := rows.Err(); err != nil {
+		return q, err
+	}
+
+	if q.Q, err = toInt64(v); err != nil {
+		return q, err
+	}
+
+	s.log.Debugf("Retrieved sql query input: %s", q)
+
+	return q, nil
+}
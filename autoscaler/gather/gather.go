@@ -92,3 +92,10 @@ type Gatherer interface {
 	// Gather gathers quantitys of a given input
 	Gather(ctx context.Context) (types.Quantity, error)
 }
+
+// Pusher is implemented by the Gatherers whose quantities are pushed from outside instead
+// of gathered from a source
+type Pusher interface {
+	// Push sets the quantity that will be returned when gathering
+	Push(q types.Quantity) error
+}
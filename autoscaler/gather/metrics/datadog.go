@@ -0,0 +1,246 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	ddQueryOpt     = "query"
+	ddSiteOpt      = "site"
+	ddAPIKeyOpt    = "api_key"
+	ddAppKeyOpt    = "app_key"
+	ddStatisticOpt = "statistic"
+	ddOffsetOpt    = "offset"
+	ddWindowOpt    = "window"
+	ddTimeoutOpt   = "timeout"
+
+	// Defaults
+	ddDefaultSite    = "https://api.datadoghq.com"
+	ddDefaultWindow  = 5 * time.Minute
+	ddDefaultTimeout = 10 * time.Second
+
+	// API
+	ddQueryPath = "/api/v1/query"
+
+	// the name
+	ddRegName = "datadog_metric"
+)
+
+// ddQueryResponse is the response of the Datadog query API
+type ddQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Series []struct {
+		Metric    string        `json:"metric"`
+		Scope     string        `json:"scope"`
+		Pointlist [][2]*float64 `json:"pointlist"`
+	} `json:"series"`
+}
+
+// DatadogMetric represents an object for gathering metrics from Datadog
+type DatadogMetric struct {
+	site      string        // The Datadog API site
+	apiKey    string        // Datadog API key
+	appKey    string        // Datadog application key
+	qry       string        // The metrics query
+	statistic string        // The statistic to reduce the serie (last, avg, max, min, sum)
+	offset    time.Duration // The offset to apply to the query, the last values of Datadog are usually incomplete
+	window    time.Duration // The time window of the query
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+type datadogMetricCreator struct{}
+
+// Create will create a DatadogMetric object
+func (d *datadogMetricCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewDatadogMetric(ctx, opts)
+}
+
+func init() {
+	gather.Register(ddRegName, &datadogMetricCreator{})
+}
+
+// NewDatadogMetric creates a Datadog gatherer
+func NewDatadogMetric(ctx context.Context, opts map[string]interface{}) (d *DatadogMetric, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	d = &DatadogMetric{
+		site:   ddDefaultSite,
+		window: ddDefaultWindow,
+	}
+
+	var ok bool
+
+	// Check query
+	if d.qry, ok = opts[ddQueryOpt].(string); !ok || d.qry == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ddQueryOpt)
+	}
+
+	// Check keys
+	if d.apiKey, ok = opts[ddAPIKeyOpt].(string); !ok || d.apiKey == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ddAPIKeyOpt)
+	}
+
+	if d.appKey, ok = opts[ddAppKeyOpt].(string); !ok || d.appKey == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ddAppKeyOpt)
+	}
+
+	// Check site
+	if v, ok := opts[ddSiteOpt]; ok {
+		d.site = v.(string)
+	}
+	if _, err = url.Parse(d.site); err != nil || d.site == "" {
+		return nil, fmt.Errorf("%s configuration opt is wrong", ddSiteOpt)
+	}
+	d.site = strings.TrimRight(d.site, "/")
+
+	// Check statistic
+	if d.statistic, ok = opts[ddStatisticOpt].(string); !ok {
+		return nil, fmt.Errorf("%s configuration opt is required", ddStatisticOpt)
+	}
+
+	if !validReducer(d.statistic) {
+		return nil, fmt.Errorf("%s configuration opt is wrong", ddStatisticOpt)
+	}
+
+	// durations
+	ts, ok := opts[ddOffsetOpt].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s configuration opt is wrong", ddOffsetOpt)
+	}
+	if d.offset, err = time.ParseDuration(ts); err != nil {
+		return nil, err
+	}
+	if d.offset > 0 {
+		return nil, fmt.Errorf("%s should be 0 or negative", ddOffsetOpt)
+	}
+
+	if v, ok := opts[ddWindowOpt]; ok {
+		if d.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, err
+		}
+	}
+	if d.window <= 0 {
+		return nil, fmt.Errorf("%s should be positive", ddWindowOpt)
+	}
+
+	timeout := ddDefaultTimeout
+	if v, ok := opts[ddTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, err
+		}
+	}
+	d.client = utilhttp.NewClient(timeout, nil)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	d.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       ddRegName,
+	})
+
+	return
+}
+
+// query makes the query to the Datadog API for the given time range
+func (d *DatadogMetric) query(ctx context.Context, from, to time.Time) (*ddQueryResponse, error) {
+	v := url.Values{}
+	v.Set("query", d.qry)
+	v.Set("from", strconv.FormatInt(from.Unix(), 10))
+	v.Set("to", strconv.FormatInt(to.Unix(), 10))
+
+	req, err := http.NewRequest(http.MethodGet, d.site+ddQueryPath+"?"+v.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("DD-API-KEY", d.apiKey)
+	req.Header.Set("DD-APPLICATION-KEY", d.appKey)
+
+	resp, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("datadog API returned %d status code: %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	res := &ddQueryResponse{}
+	if err := json.Unmarshal(b, res); err != nil {
+		return nil, err
+	}
+
+	if res.Status != "ok" {
+		return nil, fmt.Errorf("datadog query returned '%s' status: %s", res.Status, res.Error)
+	}
+
+	return res, nil
+}
+
+// Gather will gather metrics from Datadog
+func (d *DatadogMetric) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	to := time.Now().UTC().Add(d.offset) // apply offset
+	from := to.Add(-d.window)
+	d.log.Debugf("Retrieving datadog metric")
+	resp, err := d.query(ctx, from, to)
+	if err != nil {
+		return q, err
+	}
+
+	// Only one serie is valid
+	if len(resp.Series) != 1 {
+		return q, fmt.Errorf("wrong series length, should be one, current is: %d", len(resp.Series))
+	}
+
+	// Reduce the serie, Datadog returns null points
+	points := []float64{}
+	for _, p := range resp.Series[0].Pointlist {
+		if p[1] != nil {
+			points = append(points, *p[1])
+		}
+	}
+	res, err := reduce(d.statistic, points)
+	if err != nil {
+		return q, err
+	}
+	q.Q = utilmath.RoundInt64(res)
+
+	d.log.Debugf("Retrieved datadog metric input: %s", q)
+
+	return q, nil
+}
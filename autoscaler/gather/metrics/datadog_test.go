@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+func TestDatadogMetricCreation(t *testing.T) {
+	tests := []struct {
+		query     string
+		apiKey    string
+		appKey    string
+		site      interface{}
+		statistic string
+		offset    string
+		window    interface{}
+
+		wantSite   string
+		wantWindow time.Duration
+		wantError  bool
+	}{
+		{"avg:aws.sqs.approximate_number_of_messages_visible{queuename:jobs}", "api", "app", nil, "last", "0s", nil, ddDefaultSite, ddDefaultWindow, false},
+		{"avg:trace.http.request.duration{service:api}", "api", "app", "https://api.datadoghq.eu/", "avg", "-1m", "10m", "https://api.datadoghq.eu", 10 * time.Minute, false},
+		{"", "api", "app", nil, "last", "0s", nil, "", 0, true},
+		{"avg:system.cpu.user{*}", "", "app", nil, "last", "0s", nil, "", 0, true},
+		{"avg:system.cpu.user{*}", "api", "", nil, "last", "0s", nil, "", 0, true},
+		{"avg:system.cpu.user{*}", "api", "app", nil, "p95", "0s", nil, "", 0, true},
+		{"avg:system.cpu.user{*}", "api", "app", nil, "last", "1m", nil, "", 0, true},
+		{"avg:system.cpu.user{*}", "api", "app", nil, "last", "0s", "-1m", "", 0, true},
+		{"avg:system.cpu.user{*}", "api", "app", "", "last", "0s", nil, "", 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			ddQueryOpt:     test.query,
+			ddAPIKeyOpt:    test.apiKey,
+			ddAppKeyOpt:    test.appKey,
+			ddStatisticOpt: test.statistic,
+			ddOffsetOpt:    test.offset,
+		}
+		if test.site != nil {
+			opts[ddSiteOpt] = test.site
+		}
+		if test.window != nil {
+			opts[ddWindowOpt] = test.window
+		}
+
+		d, err := NewDatadogMetric(context.TODO(), opts)
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if d.site != test.wantSite {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantSite, d.site)
+		}
+
+		if d.window != test.wantWindow {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantWindow, d.window)
+		}
+	}
+}
+
+func TestDatadogMetricGather(t *testing.T) {
+	tests := []struct {
+		response  string
+		code      int
+		statistic string
+
+		wantValue int64
+		wantError bool
+	}{
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,30.4]]}]}`, http.StatusOK, reducerLast, 30, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,30.0]]}]}`, http.StatusOK, reducerAvg, 20, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,30.0]]}]}`, http.StatusOK, reducerSum, 60, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,30.0]]}]}`, http.StatusOK, reducerMax, 30, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,30.0]]}]}`, http.StatusOK, reducerMin, 10, false},
+		// Null points are ignored
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0],[2,20.0],[3,null]]}]}`, http.StatusOK, reducerLast, 20, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,null],[2,20.0],[3,null]]}]}`, http.StatusOK, reducerAvg, 20, false},
+		{`{"status":"ok","series":[{"pointlist":[[1,null]]}]}`, http.StatusOK, reducerAvg, 0, true},
+		// Multiple or no series are bad
+		{`{"status":"ok","series":[{"pointlist":[[1,10.0]]},{"pointlist":[[1,10.0]]}]}`, http.StatusOK, reducerLast, 0, true},
+		{`{"status":"ok","series":[]}`, http.StatusOK, reducerLast, 0, true},
+		// API errors are bad
+		{`{"status":"error","error":"Rule parse error"}`, http.StatusOK, reducerLast, 0, true},
+		{`{"errors":["Forbidden"]}`, http.StatusForbidden, reducerLast, 0, true},
+		{`{"status":"ok","series":[`, http.StatusOK, reducerLast, 0, true},
+	}
+
+	for _, test := range tests {
+		window := 5 * time.Minute
+		offset := -1 * time.Minute
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Check the request is correct
+			if r.URL.Path != ddQueryPath ||
+				r.Header.Get("DD-API-KEY") != "api" ||
+				r.Header.Get("DD-APPLICATION-KEY") != "app" ||
+				r.URL.Query().Get("query") != "avg:system.cpu.user{*}" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			from, _ := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+			to, _ := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+			if time.Duration(to-from)*time.Second != window {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if now := time.Now().UTC().Unix(); to > now+int64(offset/time.Second) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(test.code)
+			fmt.Fprint(w, test.response)
+		}))
+
+		d := &DatadogMetric{
+			site:      ts.URL,
+			apiKey:    "api",
+			appKey:    "app",
+			qry:       "avg:system.cpu.user{*}",
+			statistic: test.statistic,
+			offset:    offset,
+			window:    window,
+			client:    utilhttp.NewClient(ddDefaultTimeout, nil),
+			log:       log.New(),
+		}
+
+		q, err := d.Gather(context.TODO())
+		ts.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantValue {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantValue, q.Q)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	gphAddressOpt            = "address"
+	gphTargetOpt             = "target"
+	gphWindowOpt             = "window"
+	gphReducerOpt            = "reducer"
+	gphTimeoutOpt            = "timeout"
+	gphUsernameOpt           = "username"
+	gphPasswordOpt           = "password"
+	gphCAFileOpt             = "ca_file"
+	gphInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// Defaults
+	gphDefaultWindow  = 5 * time.Minute
+	gphDefaultReducer = reducerLast
+	gphDefaultTimeout = 10 * time.Second
+
+	// the name
+	gphRegName = "graphite_query"
+)
+
+// gphSeries is a series of the Graphite render API JSON response, the datapoints
+// are [value, timestamp] pairs
+type gphSeries struct {
+	Target     string        `json:"target"`
+	Datapoints [][2]*float64 `json:"datapoints"`
+}
+
+// GraphiteQuery represents an object for gathering inputs from the Graphite render API
+type GraphiteQuery struct {
+	address  string
+	target   string        // The Graphite target expression
+	window   time.Duration // The time window of the render query
+	reducer  string        // The reducer of multiple samples (sum, max, min, avg, last)
+	username string
+	password string
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+type graphiteQueryCreator struct{}
+
+// Create will create a GraphiteQuery object
+func (g *graphiteQueryCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewGraphiteQuery(ctx, opts)
+}
+
+func init() {
+	gather.Register(gphRegName, &graphiteQueryCreator{})
+}
+
+// NewGraphiteQuery creates a Graphite gatherer
+func NewGraphiteQuery(ctx context.Context, opts map[string]interface{}) (g *GraphiteQuery, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	g = &GraphiteQuery{
+		window:  gphDefaultWindow,
+		reducer: gphDefaultReducer,
+	}
+
+	var ok bool
+
+	if g.address, ok = opts[gphAddressOpt].(string); !ok || g.address == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", gphAddressOpt)
+	}
+	g.address = strings.TrimRight(g.address, "/")
+	u, err := url.ParseRequestURI(g.address)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", gphAddressOpt, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s is not an HTTP address", gphAddressOpt, g.address)
+	}
+
+	if g.target, ok = opts[gphTargetOpt].(string); !ok || g.target == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", gphTargetOpt)
+	}
+
+	// Graphite resolution is in seconds
+	if v, ok := opts[gphWindowOpt]; ok {
+		if g.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", gphWindowOpt, err)
+		}
+	}
+	if g.window < time.Second {
+		return nil, fmt.Errorf("%s should be one second or greater", gphWindowOpt)
+	}
+
+	if v, ok := opts[gphReducerOpt]; ok {
+		g.reducer = v.(string)
+	}
+	if !validReducer(g.reducer) {
+		return nil, fmt.Errorf("%s configuration opt is wrong", gphReducerOpt)
+	}
+
+	// Auth
+	if v, ok := opts[gphUsernameOpt]; ok {
+		g.username = v.(string)
+	}
+	if v, ok := opts[gphPasswordOpt]; ok {
+		g.password = v.(string)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[gphCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[gphInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", gphCAFileOpt, err)
+	}
+
+	timeout := gphDefaultTimeout
+	if v, ok := opts[gphTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", gphTimeoutOpt, err)
+		}
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", gphTimeoutOpt)
+	}
+	g.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	g.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       gphRegName,
+	})
+
+	return
+}
+
+// Gather will gather metrics from Graphite
+func (g *GraphiteQuery) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	params := url.Values{
+		"target": {g.target},
+		"from":   {fmt.Sprintf("-%ds", int64(g.window/time.Second))},
+		"until":  {"now"},
+		"format": {"json"},
+	}
+	req, err := http.NewRequest(http.MethodGet, g.address+"/render?"+params.Encode(), nil)
+	if err != nil {
+		return q, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if g.username != "" {
+		req.SetBasicAuth(g.username, g.password)
+	}
+
+	g.log.Debugf("Querying %s", g.address)
+	resp, err := g.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return q, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return q, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return q, fmt.Errorf("%s returned %d status code", g.address, resp.StatusCode)
+	}
+
+	series := []gphSeries{}
+	if err := json.Unmarshal(b, &series); err != nil {
+		return q, fmt.Errorf("error decoding response: %s", err)
+	}
+
+	// The window is already applied by Graphite
+	ss := []sample{}
+	for _, s := range series {
+		for _, dp := range s.Datapoints {
+			// Null values are missing values
+			if dp[0] == nil || dp[1] == nil {
+				continue
+			}
+			ss = append(ss, sample{ts: time.Unix(int64(*dp[1]), 0), value: *dp[0]})
+		}
+	}
+
+	v, err := reduce(g.reducer, windowValues(ss, time.Time{}))
+	if err != nil {
+		return q, fmt.Errorf("graphite returned %s", err)
+	}
+	q.Q = utilmath.RoundInt64(v)
+
+	g.log.Debugf("Retrieved graphite input: %s", q)
+
+	return q, nil
+}
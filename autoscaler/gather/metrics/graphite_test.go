@@ -0,0 +1,150 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestGraphiteQueryCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantWindow  time.Duration
+		wantReducer string
+		wantError   bool
+	}{
+		{
+			opts: map[string]interface{}{
+				gphAddressOpt: "http://127.0.0.1:8080",
+				gphTargetOpt:  "sumSeries(jobs.*.pending)",
+			},
+			wantWindow:  gphDefaultWindow,
+			wantReducer: reducerLast,
+		},
+		{
+			opts: map[string]interface{}{
+				gphAddressOpt:  "https://graphite.prod/",
+				gphTargetOpt:   "jobs.render.pending",
+				gphWindowOpt:   "10m",
+				gphReducerOpt:  "avg",
+				gphUsernameOpt: "ladder",
+				gphPasswordOpt: "secret",
+				gphTimeoutOpt:  "2s",
+			},
+			wantWindow:  10 * time.Minute,
+			wantReducer: reducerAvg,
+		},
+		// Missing params
+		{opts: map[string]interface{}{gphTargetOpt: "jobs.render.pending"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "http://127.0.0.1:8080"}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{gphAddressOpt: "127.0.0.1", gphTargetOpt: "jobs.render.pending"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "graphite:8080", gphTargetOpt: "jobs.render.pending"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "http://127.0.0.1:8080", gphTargetOpt: "jobs.render.pending", gphWindowOpt: "500ms"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "http://127.0.0.1:8080", gphTargetOpt: "jobs.render.pending", gphReducerOpt: "median"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "http://127.0.0.1:8080", gphTargetOpt: "jobs.render.pending", gphTimeoutOpt: "-1s"}, wantError: true},
+		{opts: map[string]interface{}{gphAddressOpt: "http://127.0.0.1:8080", gphTargetOpt: 10}, wantError: true},
+	}
+
+	for _, test := range tests {
+		g, err := NewGraphiteQuery(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if g.window != test.wantWindow || g.reducer != test.wantReducer {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantWindow, test.wantReducer, g.window, g.reducer)
+		}
+	}
+}
+
+func TestGraphiteQueryGather(t *testing.T) {
+	response := `[
+		{"target": "jobs.render.pending", "datapoints": [[10, 1500000000], [null, 1500000060], [20.6, 1500000120]]},
+		{"target": "jobs.encode.pending", "datapoints": [[5, 1500000090], [null, 1500000150]]}
+	]`
+
+	tests := []struct {
+		reducer  string
+		status   int
+		response string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{reducerLast, http.StatusOK, response, 21, false},
+		{reducerSum, http.StatusOK, response, 36, false},
+		{reducerMax, http.StatusOK, response, 21, false},
+		{reducerMin, http.StatusOK, response, 5, false},
+		{reducerAvg, http.StatusOK, response, 12, false},
+		// Empty results
+		{reducerLast, http.StatusOK, `[]`, 0, true},
+		{reducerLast, http.StatusOK, `[{"target": "jobs.render.pending", "datapoints": [[null, 1500000000]]}]`, 0, true},
+		// Errors
+		{reducerLast, http.StatusInternalServerError, `error`, 0, true},
+		{reducerLast, http.StatusOK, `{"error": "wrong"}`, 0, true},
+	}
+
+	for _, test := range tests {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			qs := r.URL.Query()
+			if r.Method != http.MethodGet || r.URL.Path != "/render" {
+				t.Errorf("Wrong request: %s %s", r.Method, r.URL.Path)
+			}
+			if qs.Get("target") != "jobs.*.pending" || qs.Get("from") != "-300s" || qs.Get("until") != "now" || qs.Get("format") != "json" {
+				t.Errorf("Wrong request params: %v", qs)
+			}
+			if u, p, _ := r.BasicAuth(); u != "ladder" || p != "secret" {
+				t.Errorf("Wrong basic auth, got: %s:%s", u, p)
+			}
+			w.WriteHeader(test.status)
+			fmt.Fprint(w, test.response)
+		}))
+
+		g, err := NewGraphiteQuery(context.TODO(), map[string]interface{}{
+			gphAddressOpt:  s.URL,
+			gphTargetOpt:   "jobs.*.pending",
+			gphReducerOpt:  test.reducer,
+			gphUsernameOpt: "ladder",
+			gphPasswordOpt: "secret",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		g.log = log.New()
+
+		q, err := g.Gather(context.TODO())
+		s.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
@@ -0,0 +1,409 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+	utilmath "github.com/themotion/ladder/util/math"
+)
+
+const (
+	// Opts
+	ifxAddressOpt            = "address"
+	ifxLanguageOpt           = "language"
+	ifxQueryOpt              = "query"
+	ifxDatabaseOpt           = "database"
+	ifxOrganizationOpt       = "organization"
+	ifxWindowOpt             = "window"
+	ifxReducerOpt            = "reducer"
+	ifxTimeoutOpt            = "timeout"
+	ifxUsernameOpt           = "username"
+	ifxPasswordOpt           = "password"
+	ifxTokenOpt              = "token"
+	ifxCAFileOpt             = "ca_file"
+	ifxInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// Query languages
+	ifxLanguageInfluxQL = "influxql"
+	ifxLanguageFlux     = "flux"
+
+	// Defaults
+	ifxDefaultWindow  = 5 * time.Minute
+	ifxDefaultReducer = reducerLast
+	ifxDefaultTimeout = 10 * time.Second
+
+	// the name
+	ifxRegName = "influxdb_query"
+)
+
+// ifxInfluxQLResponse is the response of the InfluxQL query API
+type ifxInfluxQLResponse struct {
+	Results []struct {
+		Series []struct {
+			Columns []string        `json:"columns"`
+			Values  [][]interface{} `json:"values"`
+		} `json:"series"`
+		Error string `json:"error"`
+	} `json:"results"`
+	Error string `json:"error"`
+}
+
+// InfluxDBQuery represents an object for gathering inputs from InfluxDB InfluxQL or Flux queries
+type InfluxDBQuery struct {
+	address      string
+	language     string        // The query language (influxql, flux)
+	query        string        // The query
+	database     string        // The database of InfluxQL queries
+	organization string        // The organization of Flux queries
+	window       time.Duration // Only the samples of the window will be used
+	reducer      string        // The reducer of multiple samples (sum, max, min, avg, last)
+	username     string
+	password     string
+	token        string
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+type influxDBQueryCreator struct{}
+
+// Create will create an InfluxDBQuery object
+func (i *influxDBQueryCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewInfluxDBQuery(ctx, opts)
+}
+
+func init() {
+	gather.Register(ifxRegName, &influxDBQueryCreator{})
+}
+
+// NewInfluxDBQuery creates an InfluxDB gatherer
+func NewInfluxDBQuery(ctx context.Context, opts map[string]interface{}) (i *InfluxDBQuery, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	i = &InfluxDBQuery{
+		language: ifxLanguageInfluxQL,
+		window:   ifxDefaultWindow,
+		reducer:  ifxDefaultReducer,
+	}
+
+	var ok bool
+
+	if i.address, ok = opts[ifxAddressOpt].(string); !ok || i.address == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ifxAddressOpt)
+	}
+	i.address = strings.TrimRight(i.address, "/")
+	u, err := url.ParseRequestURI(i.address)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", ifxAddressOpt, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s is not an HTTP address", ifxAddressOpt, i.address)
+	}
+
+	if i.query, ok = opts[ifxQueryOpt].(string); !ok || i.query == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", ifxQueryOpt)
+	}
+
+	if v, ok := opts[ifxLanguageOpt]; ok {
+		i.language = v.(string)
+	}
+	if v, ok := opts[ifxDatabaseOpt]; ok {
+		i.database = v.(string)
+	}
+	if v, ok := opts[ifxOrganizationOpt]; ok {
+		i.organization = v.(string)
+	}
+	switch i.language {
+	case ifxLanguageInfluxQL:
+		if i.database == "" {
+			return nil, fmt.Errorf("%s configuration opt is required on %s queries", ifxDatabaseOpt, ifxLanguageInfluxQL)
+		}
+	case ifxLanguageFlux:
+		if i.organization == "" {
+			return nil, fmt.Errorf("%s configuration opt is required on %s queries", ifxOrganizationOpt, ifxLanguageFlux)
+		}
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", ifxLanguageOpt)
+	}
+
+	if v, ok := opts[ifxWindowOpt]; ok {
+		if i.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", ifxWindowOpt, err)
+		}
+	}
+	if i.window <= 0 {
+		return nil, fmt.Errorf("%s should be positive", ifxWindowOpt)
+	}
+
+	if v, ok := opts[ifxReducerOpt]; ok {
+		i.reducer = v.(string)
+	}
+	if !validReducer(i.reducer) {
+		return nil, fmt.Errorf("%s configuration opt is wrong", ifxReducerOpt)
+	}
+
+	// Auth
+	if v, ok := opts[ifxUsernameOpt]; ok {
+		i.username = v.(string)
+	}
+	if v, ok := opts[ifxPasswordOpt]; ok {
+		i.password = v.(string)
+	}
+	if v, ok := opts[ifxTokenOpt]; ok {
+		i.token = v.(string)
+	}
+	if i.username != "" && i.token != "" {
+		return nil, fmt.Errorf("%s and %s configuration opts can't be used at the same time", ifxUsernameOpt, ifxTokenOpt)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[ifxCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[ifxInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", ifxCAFileOpt, err)
+	}
+
+	timeout := ifxDefaultTimeout
+	if v, ok := opts[ifxTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", ifxTimeoutOpt, err)
+		}
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", ifxTimeoutOpt)
+	}
+	i.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	i.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       ifxRegName,
+	})
+
+	return
+}
+
+// request creates the query request for the query language
+func (i *InfluxDBQuery) request() (*http.Request, error) {
+	var req *http.Request
+	var err error
+
+	switch i.language {
+	case ifxLanguageFlux:
+		u := fmt.Sprintf("%s/api/v2/query?%s", i.address, url.Values{"org": {i.organization}}.Encode())
+		if req, err = http.NewRequest(http.MethodPost, u, bytes.NewBufferString(i.query)); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/vnd.flux")
+		req.Header.Set("Accept", "application/csv")
+	default:
+		// Ask for epoch timestamps, this way we don't need to parse dates
+		form := url.Values{"db": {i.database}, "q": {i.query}, "epoch": {"ms"}}
+		if req, err = http.NewRequest(http.MethodPost, i.address+"/query", strings.NewReader(form.Encode())); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+	}
+
+	switch {
+	case i.token != "":
+		req.Header.Set("Authorization", "Token "+i.token)
+	case i.username != "":
+		req.SetBasicAuth(i.username, i.password)
+	}
+
+	return req, nil
+}
+
+// influxQLSamples gets the samples of all the series of the InfluxQL response, the value
+// is the first column after the time. The aggregations without time bound are returned at
+// the epoch, those samples are the result at the query time
+func influxQLSamples(b []byte, queryTime time.Time) ([]sample, error) {
+	r := ifxInfluxQLResponse{}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, fmt.Errorf("error decoding response: %s", err)
+	}
+	if r.Error != "" {
+		return nil, fmt.Errorf("query error: %s", r.Error)
+	}
+
+	ss := []sample{}
+	for _, res := range r.Results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("query error: %s", res.Error)
+		}
+		for _, s := range res.Series {
+			if len(s.Columns) < 2 || s.Columns[0] != "time" {
+				return nil, fmt.Errorf("query result needs to have time and value columns")
+			}
+			for _, v := range s.Values {
+				ts, ok := v[0].(float64)
+				if !ok {
+					return nil, fmt.Errorf("wrong time on query result: %v", v[0])
+				}
+				// Null values are missing values
+				if v[1] == nil {
+					continue
+				}
+				value, ok := v[1].(float64)
+				if !ok {
+					return nil, fmt.Errorf("query result is not a number: %v", v[1])
+				}
+				st := time.Unix(0, int64(ts)*int64(time.Millisecond))
+				if ts == 0 {
+					st = queryTime
+				}
+				ss = append(ss, sample{ts: st, value: value})
+			}
+		}
+	}
+
+	return ss, nil
+}
+
+// fluxSamples gets the samples of all the tables of the Flux annotated CSV response
+// using the _time and _value columns. The aggregations drop the _time column, those
+// samples are the result at the query time
+func fluxSamples(b []byte, queryTime time.Time) ([]sample, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = -1
+
+	ss := []sample{}
+	timeIdx, valueIdx := -1, -1
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error decoding response: %s", err)
+		}
+
+		// Ignore annotations
+		if len(record) == 0 || strings.HasPrefix(record[0], "#") {
+			continue
+		}
+
+		// Each table starts with a header (with the table column), the tables can have
+		// different columns
+		isHeader := false
+		for _, c := range record {
+			if c == "table" {
+				isHeader = true
+				break
+			}
+		}
+		if isHeader {
+			timeIdx, valueIdx = -1, -1
+			for j, c := range record {
+				switch c {
+				case "_time":
+					timeIdx = j
+				case "_value":
+					valueIdx = j
+				}
+			}
+			continue
+		}
+
+		if valueIdx < 0 || valueIdx >= len(record) || timeIdx >= len(record) {
+			return nil, fmt.Errorf("query result needs to have _value column")
+		}
+		// Empty values are missing values
+		if record[valueIdx] == "" {
+			continue
+		}
+		ts := queryTime
+		if timeIdx >= 0 {
+			if ts, err = time.Parse(time.RFC3339Nano, record[timeIdx]); err != nil {
+				return nil, fmt.Errorf("wrong time on query result: %s", err)
+			}
+		}
+		value, err := strconv.ParseFloat(record[valueIdx], 64)
+		if err != nil {
+			return nil, fmt.Errorf("query result is not a number: %s", record[valueIdx])
+		}
+		ss = append(ss, sample{ts: ts, value: value})
+	}
+
+	return ss, nil
+}
+
+// Gather will gather metrics from InfluxDB
+func (i *InfluxDBQuery) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{}
+
+	req, err := i.request()
+	if err != nil {
+		return q, err
+	}
+
+	now := time.Now().UTC()
+	i.log.Debugf("Querying %s", i.address)
+	resp, err := i.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return q, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return q, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return q, fmt.Errorf("%s returned %d status code: %s", i.address, resp.StatusCode, b)
+	}
+
+	var ss []sample
+	if i.language == ifxLanguageFlux {
+		ss, err = fluxSamples(b, now)
+	} else {
+		ss, err = influxQLSamples(b, now)
+	}
+	if err != nil {
+		return q, err
+	}
+
+	v, err := reduce(i.reducer, windowValues(ss, now.Add(-i.window)))
+	if err != nil {
+		return q, fmt.Errorf("influxdb returned %s", err)
+	}
+	q.Q = utilmath.RoundInt64(v)
+
+	i.log.Debugf("Retrieved influxdb input: %s", q)
+
+	return q, nil
+}
@@ -0,0 +1,284 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestInfluxDBQueryCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantLanguage string
+		wantWindow   time.Duration
+		wantReducer  string
+		wantError    bool
+	}{
+		{
+			opts: map[string]interface{}{
+				ifxAddressOpt:  "http://127.0.0.1:8086",
+				ifxQueryOpt:    `SELECT mean("pending") FROM "jobs" WHERE time > now() - 5m GROUP BY time(1m)`,
+				ifxDatabaseOpt: "telegraf",
+			},
+			wantLanguage: ifxLanguageInfluxQL,
+			wantWindow:   ifxDefaultWindow,
+			wantReducer:  reducerLast,
+		},
+		{
+			opts: map[string]interface{}{
+				ifxAddressOpt:      "https://influx.prod:8086/",
+				ifxQueryOpt:        `from(bucket: "jobs") |> range(start: -10m) |> filter(fn: (r) => r._field == "pending")`,
+				ifxLanguageOpt:     "flux",
+				ifxOrganizationOpt: "themotion",
+				ifxWindowOpt:       "10m",
+				ifxReducerOpt:      "max",
+				ifxTokenOpt:        "secret",
+				ifxTimeoutOpt:      "2s",
+			},
+			wantLanguage: ifxLanguageFlux,
+			wantWindow:   10 * time.Minute,
+			wantReducer:  reducerMax,
+		},
+		// Missing params
+		{opts: map[string]interface{}{ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxDatabaseOpt: "telegraf"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "from()", ifxLanguageOpt: "flux"}, wantError: true},
+		// Wrong params
+		{opts: map[string]interface{}{ifxAddressOpt: "127.0.0.1", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "influxdb:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf", ifxLanguageOpt: "sql"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf", ifxWindowOpt: "-5m"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf", ifxReducerOpt: "p99"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf", ifxTimeoutOpt: "0s"}, wantError: true},
+		{opts: map[string]interface{}{ifxAddressOpt: "http://127.0.0.1:8086", ifxQueryOpt: "SELECT 1", ifxDatabaseOpt: "telegraf", ifxUsernameOpt: "ladder", ifxTokenOpt: "secret"}, wantError: true},
+	}
+
+	for _, test := range tests {
+		i, err := NewInfluxDBQuery(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if i.language != test.wantLanguage || i.window != test.wantWindow || i.reducer != test.wantReducer {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v, %v; got %v, %v, %v", test, test.wantLanguage, test.wantWindow, test.wantReducer, i.language, i.window, i.reducer)
+		}
+	}
+}
+
+func TestInfluxDBQueryGatherInfluxQL(t *testing.T) {
+	now := time.Now()
+	ms := func(d time.Duration) int64 { return now.Add(-d).UnixNano() / int64(time.Millisecond) }
+
+	response := fmt.Sprintf(`{"results":[{"statement_id":0,"series":[
+		{"name":"jobs","tags":{"queue":"render"},"columns":["time","mean"],"values":[[%d,100],[%d,10],[%d,null],[%d,20.6]]},
+		{"name":"jobs","tags":{"queue":"encode"},"columns":["time","mean"],"values":[[%d,5]]}
+	]}]}`, ms(10*time.Minute), ms(3*time.Minute), ms(2*time.Minute), ms(30*time.Second), ms(time.Minute))
+
+	tests := []struct {
+		reducer  string
+		status   int
+		response string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{reducerLast, http.StatusOK, response, 21, false},
+		{reducerSum, http.StatusOK, response, 36, false},
+		{reducerMax, http.StatusOK, response, 21, false},
+		{reducerMin, http.StatusOK, response, 5, false},
+		{reducerAvg, http.StatusOK, response, 12, false},
+		// Aggregation without time bound
+		{reducerLast, http.StatusOK, `{"results":[{"statement_id":0,"series":[{"name":"jobs","columns":["time","mean"],"values":[[0,42.4]]}]}]}`, 42, false},
+		{reducerAvg, http.StatusOK, `{"results":[{"statement_id":0,"series":[{"name":"jobs","columns":["time","mean"],"values":[[0,42]]},{"name":"jobs","columns":["time","mean"],"values":[[0,8]]}]}]}`, 25, false},
+		// Empty results
+		{reducerLast, http.StatusOK, `{"results":[{"statement_id":0}]}`, 0, true},
+		// Errors
+		{reducerLast, http.StatusOK, `{"results":[{"statement_id":0,"error":"database not found: telegraf"}]}`, 0, true},
+		{reducerLast, http.StatusBadRequest, `{"error":"error parsing query"}`, 0, true},
+		{reducerLast, http.StatusOK, `{"results":[{"statement_id":0,"series":[{"name":"jobs","columns":["time","status"],"values":[[1,"pending"]]}]}]}`, 0, true},
+		{reducerLast, http.StatusOK, `not json`, 0, true},
+	}
+
+	for _, test := range tests {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/query" {
+				t.Errorf("Wrong request: %s %s", r.Method, r.URL.Path)
+			}
+			if r.FormValue("db") != "telegraf" || r.FormValue("epoch") != "ms" || r.FormValue("q") != "SELECT mean(pending) FROM jobs" {
+				t.Errorf("Wrong request params: %v", r.Form)
+			}
+			if u, p, _ := r.BasicAuth(); u != "ladder" || p != "secret" {
+				t.Errorf("Wrong basic auth, got: %s:%s", u, p)
+			}
+			w.WriteHeader(test.status)
+			fmt.Fprint(w, test.response)
+		}))
+
+		i, err := NewInfluxDBQuery(context.TODO(), map[string]interface{}{
+			ifxAddressOpt:  s.URL,
+			ifxQueryOpt:    "SELECT mean(pending) FROM jobs",
+			ifxDatabaseOpt: "telegraf",
+			ifxReducerOpt:  test.reducer,
+			ifxUsernameOpt: "ladder",
+			ifxPasswordOpt: "secret",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		i.log = log.New()
+
+		q, err := i.Gather(context.TODO())
+		s.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
+
+func TestInfluxDBQueryGatherFlux(t *testing.T) {
+	now := time.Now().UTC()
+	ts := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339Nano) }
+
+	response := strings.Join([]string{
+		",result,table,_start,_stop,_time,_value,_field,_measurement,queue",
+		fmt.Sprintf(",_result,0,%s,%s,%s,100,pending,jobs,render", ts(time.Hour), ts(0), ts(10*time.Minute)),
+		fmt.Sprintf(",_result,0,%s,%s,%s,10,pending,jobs,render", ts(time.Hour), ts(0), ts(3*time.Minute)),
+		fmt.Sprintf(",_result,0,%s,%s,%s,,pending,jobs,render", ts(time.Hour), ts(0), ts(2*time.Minute)),
+		fmt.Sprintf(",_result,0,%s,%s,%s,20.6,pending,jobs,render", ts(time.Hour), ts(0), ts(30*time.Second)),
+		"",
+		",result,table,_start,_stop,_value,_time,_field,_measurement,queue",
+		fmt.Sprintf(",_result,1,%s,%s,5,%s,pending,jobs,encode", ts(time.Hour), ts(0), ts(time.Minute)),
+		"",
+	}, "\r\n")
+
+	annotated := strings.Join([]string{
+		"#datatype,string,long,dateTime:RFC3339,double",
+		"#group,false,false,false,false",
+		"#default,_result,,,",
+		",result,table,_time,_value",
+		fmt.Sprintf(",,0,%s,7", ts(time.Minute)),
+		"",
+	}, "\r\n")
+
+	// mean() drops the _time column, one table per group
+	aggregated := strings.Join([]string{
+		",result,table,_start,_stop,_value,queue",
+		fmt.Sprintf(",_result,0,%s,%s,12.4,render", ts(time.Hour), ts(0)),
+		fmt.Sprintf(",_result,1,%s,%s,8,encode", ts(time.Hour), ts(0)),
+		"",
+	}, "\r\n")
+
+	// Tables with different columns
+	tables := strings.Join([]string{
+		",result,table,_time,_value",
+		fmt.Sprintf(",_result,0,%s,7", ts(time.Minute)),
+		",result,table,_value,_stop",
+		fmt.Sprintf(",_result,1,3,%s", ts(0)),
+		"",
+	}, "\r\n")
+
+	tests := []struct {
+		reducer  string
+		status   int
+		response string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{reducerLast, http.StatusOK, response, 21, false},
+		{reducerSum, http.StatusOK, response, 36, false},
+		{reducerMin, http.StatusOK, response, 5, false},
+		{reducerLast, http.StatusOK, annotated, 7, false},
+		{reducerSum, http.StatusOK, aggregated, 20, false},
+		{reducerMax, http.StatusOK, aggregated, 12, false},
+		{reducerLast, http.StatusOK, tables, 3, false},
+		{reducerSum, http.StatusOK, tables, 10, false},
+		// Empty results
+		{reducerLast, http.StatusOK, "", 0, true},
+		// Errors
+		{reducerLast, http.StatusBadRequest, `{"code":"invalid","message":"compilation failed"}`, 0, true},
+		{reducerLast, http.StatusOK, ",result,table,_time,_value\r\n,_result,0,yesterday,1\r\n", 0, true},
+		{reducerLast, http.StatusOK, ",result,table,_time,_value\r\n," + "_result,0," + ts(0) + ",pending\r\n", 0, true},
+		{reducerLast, http.StatusOK, ",result,table,_field\r\n,_result,0,pending\r\n", 0, true},
+	}
+
+	for _, test := range tests {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/api/v2/query" || r.URL.Query().Get("org") != "themotion" {
+				t.Errorf("Wrong request: %s %s", r.Method, r.URL)
+			}
+			if r.Header.Get("Authorization") != "Token secret" {
+				t.Errorf("Wrong auth, got: %s", r.Header.Get("Authorization"))
+			}
+			b, _ := ioutil.ReadAll(r.Body)
+			if string(b) != `from(bucket: "jobs")` {
+				t.Errorf("Wrong query, got: %s", b)
+			}
+			w.WriteHeader(test.status)
+			fmt.Fprint(w, test.response)
+		}))
+
+		i, err := NewInfluxDBQuery(context.TODO(), map[string]interface{}{
+			ifxAddressOpt:      s.URL,
+			ifxQueryOpt:        `from(bucket: "jobs")`,
+			ifxLanguageOpt:     "flux",
+			ifxOrganizationOpt: "themotion",
+			ifxReducerOpt:      test.reducer,
+			ifxTokenOpt:        "secret",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		i.log = log.New()
+
+		q, err := i.Gather(context.TODO())
+		s.Close()
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
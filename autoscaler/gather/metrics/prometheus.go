@@ -3,8 +3,10 @@ package metrics
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/api/prometheus"
@@ -13,23 +15,82 @@ import (
 	"github.com/themotion/ladder/autoscaler/gather"
 	"github.com/themotion/ladder/log"
 	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
 	utilmath "github.com/themotion/ladder/util/math"
 )
 
 const (
 	// Opts
-	pmAddresses = "addresses"
-	pmQuery     = "query"
+	pmAddresses          = "addresses"
+	pmQuery              = "query"
+	pmQueryType          = "query_type"
+	pmWindow             = "window"
+	pmStep               = "step"
+	pmReducer            = "reducer"
+	pmLabelMatch         = "label_match"
+	pmUsername           = "username"
+	pmPassword           = "password"
+	pmBearerToken        = "bearer_token"
+	pmCAFile             = "ca_file"
+	pmInsecureSkipVerify = "insecure_skip_verify"
+
+	// Query types
+	pmQueryInstant = "instant"
+	pmQueryRange   = "range"
+
+	// Reducers
+	pmReducerSum = reducerSum
+	pmReducerMax = reducerMax
+	pmReducerMin = reducerMin
+	pmReducerAvg = reducerAvg
+
+	// Defaults
+	pmDefaultWindow = 5 * time.Minute
+	pmDefaultStep   = time.Minute
 
 	// the name
 	pmRegName = "prometheus_metric"
 	pmType    = ""
 )
 
+// pmAuthTransport is a prometheus client transport that authenticates the requests
+type pmAuthTransport struct {
+	*http.Transport
+
+	username    string
+	password    string
+	bearerToken string
+}
+
+// RoundTrip satisfies http.RoundTripper interface
+func (t *pmAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Don't modify the original request
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+
+	switch {
+	case t.bearerToken != "":
+		r.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	case t.username != "":
+		r.SetBasicAuth(t.username, t.password)
+	}
+
+	return t.Transport.RoundTrip(r)
+}
+
 // PrometheusMetric represents an object for gathering metrics from Prometheus
 type PrometheusMetric struct {
-	addresses []string
-	qry       string
+	addresses  []string
+	qry        string
+	queryType  string            // The type of the query (instant, range)
+	window     time.Duration     // The time window of the range queries
+	step       time.Duration     // The step of the range queries
+	reducer    string            // The reducer of multiple samples (sum, max, min, avg)
+	labelMatch map[string]string // Only the series with these labels will be used
 
 	apiCs []prometheus.QueryAPI // api clients one per endpoint
 	log   *log.Log              // custom logger
@@ -56,7 +117,12 @@ func NewPrometheusMetric(ctx context.Context, opts map[string]interface{}) (p *P
 		}
 	}()
 
-	p = &PrometheusMetric{}
+	p = &PrometheusMetric{
+		queryType:  pmQueryInstant,
+		window:     pmDefaultWindow,
+		step:       pmDefaultStep,
+		labelMatch: map[string]string{},
+	}
 
 	var ok bool
 
@@ -79,11 +145,89 @@ func NewPrometheusMetric(ctx context.Context, opts map[string]interface{}) (p *P
 		return nil, fmt.Errorf("%s configuration opt is required", pmQuery)
 	}
 
+	// Query type
+	if v, ok := opts[pmQueryType]; ok {
+		p.queryType = v.(string)
+	}
+	switch p.queryType {
+	case pmQueryInstant, pmQueryRange:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", pmQueryType)
+	}
+
+	if v, ok := opts[pmWindow]; ok {
+		if p.window, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", pmWindow, err)
+		}
+	}
+	if p.window <= 0 {
+		return nil, fmt.Errorf("%s should be positive", pmWindow)
+	}
+
+	if v, ok := opts[pmStep]; ok {
+		if p.step, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", pmStep, err)
+		}
+	}
+	if p.step <= 0 {
+		return nil, fmt.Errorf("%s should be positive", pmStep)
+	}
+
+	// Reduction
+	if v, ok := opts[pmReducer]; ok {
+		p.reducer = v.(string)
+	}
+	switch p.reducer {
+	case pmReducerSum, pmReducerMax, pmReducerMin, pmReducerAvg:
+	case "":
+		// Range queries return multiple samples always
+		if p.queryType == pmQueryRange {
+			return nil, fmt.Errorf("%s configuration opt is required on %s queries", pmReducer, pmQueryRange)
+		}
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", pmReducer)
+	}
+
+	if v, ok := opts[pmLabelMatch]; ok {
+		for k, lv := range v.(map[interface{}]interface{}) {
+			p.labelMatch[k.(string)] = lv.(string)
+		}
+	}
+
+	// Auth & TLS
+	tr := &pmAuthTransport{}
+	if v, ok := opts[pmUsername]; ok {
+		tr.username = v.(string)
+	}
+	if v, ok := opts[pmPassword]; ok {
+		tr.password = v.(string)
+	}
+	if v, ok := opts[pmBearerToken]; ok {
+		tr.bearerToken = v.(string)
+	}
+	if tr.username != "" && tr.bearerToken != "" {
+		return nil, fmt.Errorf("%s and %s configuration opts can't be used at the same time", pmUsername, pmBearerToken)
+	}
+
+	var caFile string
+	var insecure bool
+	if v, ok := opts[pmCAFile]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[pmInsecureSkipVerify]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", pmCAFile, err)
+	}
+	tr.Transport = utilhttp.NewTransport(tlsCfg)
+
 	p.apiCs = make([]prometheus.QueryAPI, len(p.addresses))
 
 	for i, a := range p.addresses {
 		// Create the client
-		c, err := prometheus.New(prometheus.Config{Address: a})
+		c, err := prometheus.New(prometheus.Config{Address: a, Transport: tr})
 		if err != nil {
 			return nil, err
 		}
@@ -106,12 +250,18 @@ func NewPrometheusMetric(ctx context.Context, opts map[string]interface{}) (p *P
 
 // query wraps the query to Prometheus handling the retry of the queries on
 // different Prometheus endpoints
-func (p *PrometheusMetric) query(q string, ts time.Time) (model.Value, error) {
+func (p *PrometheusMetric) query(ctx context.Context, q string, ts time.Time) (model.Value, error) {
 	errs := []error{}
 
 	// Make the query in order on each enpoint until one success
 	for i, c := range p.apiCs {
-		res, err := c.Query(context.TODO(), q, ts)
+		var res model.Value
+		var err error
+		if p.queryType == pmQueryRange {
+			res, err = c.QueryRange(ctx, q, prometheus.Range{Start: ts.Add(-p.window), End: ts, Step: p.step})
+		} else {
+			res, err = c.Query(ctx, q, ts)
+		}
 		// if ok then return the response
 		if err == nil {
 			return res, nil
@@ -127,34 +277,93 @@ func (p *PrometheusMetric) query(q string, ts time.Time) (model.Value, error) {
 		b.WriteString("; ")
 	}
 
-	return nil, fmt.Errorf(b.String())
+	return nil, errors.New(b.String())
+}
+
+// matches checks if a metric has all the labels of the label match
+func (p *PrometheusMetric) matches(m model.Metric) bool {
+	for k, v := range p.labelMatch {
+		if string(m[model.LabelName(k)]) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// values returns the sample values of the series that match the labels
+func (p *PrometheusMetric) values(resp model.Value) ([]float64, error) {
+	vs := []float64{}
+
+	if p.queryType == pmQueryRange {
+		if resp.Type() != model.ValMatrix {
+			return nil, fmt.Errorf("received metric needs to be a matrix, received: %s", resp.Type())
+		}
+		for _, ss := range resp.(model.Matrix) {
+			if !p.matches(ss.Metric) {
+				continue
+			}
+			for _, s := range ss.Values {
+				vs = append(vs, float64(s.Value))
+			}
+		}
+		return vs, nil
+	}
+
+	// Only vectors are valid metrics on instant queries
+	if resp.Type() != model.ValVector {
+		return nil, fmt.Errorf("received metric needs to be a vector, received: %s", resp.Type())
+	}
+	for _, s := range resp.(model.Vector) {
+		if p.matches(s.Metric) {
+			vs = append(vs, float64(s.Value))
+		}
+	}
+
+	return vs, nil
+}
+
+// reduce reduces multiple values to one using the reducer, NaN values are ignored
+func (p *PrometheusMetric) reduce(vs []float64) (float64, error) {
+	v, err := reduce(p.reducer, vs)
+	if err != nil {
+		return 0, fmt.Errorf("prometheus returned %s", err)
+	}
+	return v, nil
 }
 
 // Gather will gather metrics from prometheus
-func (p *PrometheusMetric) Gather(_ context.Context) (types.Quantity, error) {
+func (p *PrometheusMetric) Gather(ctx context.Context) (types.Quantity, error) {
 	q := types.Quantity{}
 	// Make query request
-	resp, err := p.query(p.qry, time.Now().UTC())
+	resp, err := p.query(ctx, p.qry, time.Now().UTC())
 	if err != nil {
 		return q, err
 	}
 
-	// Only vectors are valid metrics
-	if resp.Type() != model.ValVector {
-		return q, fmt.Errorf("received metric needs to be a vector, received: %s", resp.Type())
+	vs, err := p.values(resp)
+	if err != nil {
+		return q, err
 	}
-	m := resp.(model.Vector)
 
-	// Only one sample is valid
-	if len(m) != 1 {
-		return q, fmt.Errorf("wrong samples length, should be one, current is: %d", len(m))
-	}
+	var v float64
+	if p.reducer == "" {
+		// Without reducer only one sample is valid
+		if len(vs) != 1 {
+			return q, fmt.Errorf("wrong samples length, should be one, current is: %d", len(vs))
+		}
 
-	// Get the value (round the value) and if there is no metric error
-	v := float64((*model.Sample)(m[0]).Value)
-	if math.IsNaN(v) {
-		return q, fmt.Errorf("prometheus returned a metric is NaN, this means no metric")
+		// If there is no metric error
+		v = vs[0]
+		if math.IsNaN(v) {
+			return q, fmt.Errorf("prometheus returned a metric is NaN, this means no metric")
+		}
+	} else {
+		if v, err = p.reduce(vs); err != nil {
+			return q, err
+		}
 	}
+
+	// Round the value
 	q.Q = utilmath.RoundInt64(v)
 
 	p.log.Debugf("Got prometheus metric:\n  - %s", resp)
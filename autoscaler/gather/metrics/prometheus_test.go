@@ -2,7 +2,10 @@ package metrics
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -76,7 +79,11 @@ func (q *queryAPITestClient) Query(ctx context.Context, query string, ts time.Ti
 }
 
 func (q *queryAPITestClient) QueryRange(ctx context.Context, query string, r prometheus.Range) (model.Value, error) {
-	return nil, nil
+	var err error
+	if q.wantError {
+		err = errors.New("Wrong!")
+	}
+	return q.value, err
 }
 
 func TestPrometheusMetricGather(t *testing.T) {
@@ -293,3 +300,241 @@ func TestPrometheusMetricGatherRetries(t *testing.T) {
 	}
 
 }
+
+func TestPrometheusMetricCreationOptions(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantQueryType string
+		wantReducer   string
+		wantError     bool
+	}{
+		{
+			opts:          map[string]interface{}{},
+			wantQueryType: pmQueryInstant,
+		},
+		{
+			opts: map[string]interface{}{
+				pmReducer:    pmReducerSum,
+				pmLabelMatch: map[interface{}]interface{}{"queue": "renders"},
+				pmUsername:   "ladder",
+				pmPassword:   "secret",
+			},
+			wantQueryType: pmQueryInstant,
+			wantReducer:   pmReducerSum,
+		},
+		{
+			opts: map[string]interface{}{
+				pmQueryType:          pmQueryRange,
+				pmWindow:             "10m",
+				pmStep:               "30s",
+				pmReducer:            pmReducerAvg,
+				pmBearerToken:        "token",
+				pmInsecureSkipVerify: true,
+			},
+			wantQueryType: pmQueryRange,
+			wantReducer:   pmReducerAvg,
+		},
+		// Range queries require reducer
+		{opts: map[string]interface{}{pmQueryType: pmQueryRange}, wantError: true},
+		{opts: map[string]interface{}{pmQueryType: "series"}, wantError: true},
+		{opts: map[string]interface{}{pmReducer: "p99"}, wantError: true},
+		{opts: map[string]interface{}{pmQueryType: pmQueryRange, pmReducer: pmReducerMax, pmWindow: "0s"}, wantError: true},
+		{opts: map[string]interface{}{pmQueryType: pmQueryRange, pmReducer: pmReducerMax, pmStep: "1"}, wantError: true},
+		{opts: map[string]interface{}{pmUsername: "ladder", pmBearerToken: "token"}, wantError: true},
+		{opts: map[string]interface{}{pmCAFile: "/tmp/does/not/exist.crt"}, wantError: true},
+	}
+
+	for _, test := range tests {
+		test.opts[pmAddresses] = []interface{}{"http://10.0.2.235:9090"}
+		test.opts[pmQuery] = "queue_messages"
+		p, err := NewPrometheusMetric(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if p.queryType != test.wantQueryType || p.reducer != test.wantReducer {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantQueryType, test.wantReducer, p.queryType, p.reducer)
+		}
+	}
+}
+
+func TestPrometheusMetricGatherReducers(t *testing.T) {
+	now := model.Time(time.Now().UTC().UnixNano() / 1000000)
+	vector := model.Vector{
+		&model.Sample{Metric: model.Metric{"queue": "renders", "env": "prod"}, Timestamp: now, Value: 10},
+		&model.Sample{Metric: model.Metric{"queue": "uploads", "env": "prod"}, Timestamp: now, Value: 25.4},
+		&model.Sample{Metric: model.Metric{"queue": "renders", "env": "dev"}, Timestamp: now, Value: 3},
+		&model.Sample{Metric: model.Metric{"queue": "mails", "env": "prod"}, Timestamp: now, Value: model.SampleValue(math.NaN())},
+	}
+	matrix := model.Matrix{
+		&model.SampleStream{
+			Metric: model.Metric{"queue": "renders"},
+			Values: []model.SamplePair{{Timestamp: now, Value: 10}, {Timestamp: now, Value: 20}, {Timestamp: now, Value: 30}},
+		},
+		&model.SampleStream{
+			Metric: model.Metric{"queue": "uploads"},
+			Values: []model.SamplePair{{Timestamp: now, Value: 100}, {Timestamp: now, Value: model.SampleValue(math.NaN())}},
+		},
+	}
+
+	tests := []struct {
+		v          model.Value
+		queryType  string
+		reducer    string
+		labelMatch map[string]string
+
+		wantValue int64
+		wantError bool
+	}{
+		{vector, pmQueryInstant, pmReducerSum, nil, 38, false},
+		{vector, pmQueryInstant, pmReducerMax, nil, 25, false},
+		{vector, pmQueryInstant, pmReducerMin, nil, 3, false},
+		{vector, pmQueryInstant, pmReducerAvg, nil, 13, false},
+		{vector, pmQueryInstant, pmReducerSum, map[string]string{"queue": "renders"}, 13, false},
+		// Label match without reducer needs to select only one sample
+		{vector, pmQueryInstant, "", map[string]string{"queue": "renders", "env": "prod"}, 10, false},
+		{vector, pmQueryInstant, "", map[string]string{"queue": "renders"}, 0, true},
+		{vector, pmQueryInstant, pmReducerSum, map[string]string{"queue": "missing"}, 0, true},
+		{vector, pmQueryInstant, pmReducerSum, map[string]string{"queue": "mails"}, 0, true},
+		{vector, pmQueryInstant, "", map[string]string{"queue": "mails"}, 0, true},
+		// Range queries
+		{matrix, pmQueryRange, pmReducerAvg, map[string]string{"queue": "renders"}, 20, false},
+		{matrix, pmQueryRange, pmReducerMax, nil, 100, false},
+		{matrix, pmQueryRange, pmReducerSum, nil, 160, false},
+		{matrix, pmQueryRange, pmReducerMin, map[string]string{"queue": "uploads"}, 100, false},
+		// Wrong result types are bad
+		{vector, pmQueryRange, pmReducerSum, nil, 0, true},
+		{matrix, pmQueryInstant, pmReducerSum, nil, 0, true},
+	}
+
+	for _, test := range tests {
+		p := &PrometheusMetric{
+			qry:        "queue_messages",
+			queryType:  test.queryType,
+			reducer:    test.reducer,
+			labelMatch: test.labelMatch,
+			apiCs:      []prometheus.QueryAPI{&queryAPITestClient{value: test.v}},
+			log:        log.New(),
+		}
+
+		q, err := p.Gather(context.TODO())
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantValue {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantValue, q.Q)
+		}
+	}
+}
+
+func TestPrometheusMetricGatherHTTPFailover(t *testing.T) {
+	instantResp := `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1500000000,"42"]}]}}`
+	rangeResp := `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{},"values":[[1500000000,"10"],[1500000060,"20"]]}]}}`
+
+	// Prometheus behind an authenticating proxy
+	newServer := func(up bool) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !up {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			if u, p, ok := r.BasicAuth(); !ok || u != "ladder" || p != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.URL.Path {
+			case "/api/v1/query":
+				fmt.Fprint(w, instantResp)
+			case "/api/v1/query_range":
+				if r.URL.Query().Get("step") != "30.000" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				fmt.Fprint(w, rangeResp)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	tests := []struct {
+		endpointsUp []bool
+		queryType   string
+		password    string
+
+		wantValue int64
+		wantError bool
+	}{
+		{[]bool{true}, pmQueryInstant, "secret", 42, false},
+		{[]bool{false, true}, pmQueryInstant, "secret", 42, false},
+		{[]bool{false, false, true}, pmQueryRange, "secret", 15, false},
+		{[]bool{false, false}, pmQueryInstant, "secret", 0, true},
+		// Wrong auth is bad
+		{[]bool{true, true}, pmQueryInstant, "wrong", 0, true},
+	}
+
+	for _, test := range tests {
+		addrs := []interface{}{}
+		servers := []*httptest.Server{}
+		for _, up := range test.endpointsUp {
+			ts := newServer(up)
+			servers = append(servers, ts)
+			addrs = append(addrs, ts.URL)
+		}
+
+		p, err := NewPrometheusMetric(context.TODO(), map[string]interface{}{
+			pmAddresses: addrs,
+			pmQuery:     "queue_messages",
+			pmQueryType: test.queryType,
+			pmStep:      "30s",
+			pmReducer:   pmReducerAvg,
+			pmUsername:  "ladder",
+			pmPassword:  test.password,
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+		p.log = log.New()
+
+		q, err := p.Gather(context.TODO())
+		for _, ts := range servers {
+			ts.Close()
+		}
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantValue {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantValue, q.Q)
+		}
+	}
+}
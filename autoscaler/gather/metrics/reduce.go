@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Reducers of multiple samples
+const (
+	reducerSum  = "sum"
+	reducerMax  = "max"
+	reducerMin  = "min"
+	reducerAvg  = "avg"
+	reducerLast = "last"
+)
+
+// validReducer checks if the reducer is a known one
+func validReducer(reducer string) bool {
+	switch reducer {
+	case reducerSum, reducerMax, reducerMin, reducerAvg, reducerLast:
+		return true
+	}
+	return false
+}
+
+// reduce reduces multiple values to one using the reducer, NaN values are ignored,
+// the values need to be sorted by time for the last reducer
+func reduce(reducer string, vs []float64) (float64, error) {
+	valid := []float64{}
+	for _, v := range vs {
+		if !math.IsNaN(v) {
+			valid = append(valid, v)
+		}
+	}
+	if len(valid) == 0 {
+		return 0, fmt.Errorf("only NaN or no samples, this means no metric")
+	}
+
+	var res float64
+	switch reducer {
+	case reducerSum, reducerAvg:
+		for _, v := range valid {
+			res += v
+		}
+		if reducer == reducerAvg {
+			res = res / float64(len(valid))
+		}
+	case reducerMax:
+		res = math.Inf(-1)
+		for _, v := range valid {
+			res = math.Max(res, v)
+		}
+	case reducerMin:
+		res = math.Inf(1)
+		for _, v := range valid {
+			res = math.Min(res, v)
+		}
+	case reducerLast:
+		res = valid[len(valid)-1]
+	default:
+		return 0, fmt.Errorf("invalid reducer: %s", reducer)
+	}
+
+	return res, nil
+}
+
+// sample is a value of a time series
+type sample struct {
+	ts    time.Time
+	value float64
+}
+
+// samplesByTime sorts the samples by time
+type samplesByTime []sample
+
+func (s samplesByTime) Len() int           { return len(s) }
+func (s samplesByTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s samplesByTime) Less(i, j int) bool { return s[i].ts.Before(s[j].ts) }
+
+// windowValues returns the values of the samples since a moment sorted by time
+func windowValues(ss []sample, since time.Time) []float64 {
+	sort.Stable(samplesByTime(ss))
+	vs := []float64{}
+	for _, s := range ss {
+		if !s.ts.Before(since) {
+			vs = append(vs, s.value)
+		}
+	}
+	return vs
+}
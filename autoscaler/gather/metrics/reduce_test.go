@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		reducer string
+		values  []float64
+
+		want      float64
+		wantError bool
+	}{
+		{reducerSum, []float64{1, 2, 3.5}, 6.5, false},
+		{reducerMax, []float64{1, 7, 3}, 7, false},
+		{reducerMin, []float64{4, -2, 3}, -2, false},
+		{reducerAvg, []float64{1, 2, 6}, 3, false},
+		{reducerLast, []float64{1, 2, 6}, 6, false},
+		{reducerLast, []float64{1, 2, math.NaN()}, 2, false},
+		{reducerSum, []float64{math.NaN(), 2}, 2, false},
+		{reducerSum, []float64{}, 0, true},
+		{reducerSum, []float64{math.NaN()}, 0, true},
+		{"p99", []float64{1}, 0, true},
+	}
+
+	for _, test := range tests {
+		got, err := reduce(test.reducer, test.values)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Reduce should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Reduce shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if got != test.want {
+			t.Errorf("\n- %+v\n  Wrong reduced value, want: %v; got %v", test, test.want, got)
+		}
+	}
+}
+
+func TestWindowValues(t *testing.T) {
+	now := time.Now()
+	ss := []sample{
+		{now.Add(-1 * time.Minute), 3},
+		{now.Add(-10 * time.Minute), 1},
+		{now, 4},
+		{now.Add(-2 * time.Minute), 2},
+	}
+
+	got := windowValues(ss, now.Add(-5*time.Minute))
+	want := []float64{2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Wrong window values, want: %v; got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Wrong window values, want: %v; got %v", want, got)
+		}
+	}
+}
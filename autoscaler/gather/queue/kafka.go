@@ -0,0 +1,339 @@
+package queue
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+const (
+	// Opts
+	kfkBrokersOpt            = "brokers"
+	kfkConsumerGroupOpt      = "consumer_group"
+	kfkTopicsOpt             = "topics"
+	kfkLagAggregationOpt     = "lag_aggregation"
+	kfkVersionOpt            = "version"
+	kfkSASLUsernameOpt       = "sasl_username"
+	kfkSASLPasswordOpt       = "sasl_password"
+	kfkTLSOpt                = "tls"
+	kfkCAFileOpt             = "ca_file"
+	kfkCertFileOpt           = "cert_file"
+	kfkKeyFileOpt            = "key_file"
+	kfkInsecureSkipVerifyOpt = "insecure_skip_verify"
+
+	// Lag aggregations
+	kfkLagTotal = "total"
+	kfkLagMax   = "max"
+
+	// Client id used when connecting to the brokers
+	kfkClientID = "ladder"
+
+	// the name
+	kfkRegName = "kafka_consumer_group_lag"
+)
+
+// kafkaClient is the client used to get the offsets required to calculate the lag
+type kafkaClient interface {
+	// Partitions returns the partitions of a topic
+	Partitions(topic string) ([]int32, error)
+	// GetOffset returns the offset of a topic partition at a given time (sarama.OffsetNewest/OffsetOldest)
+	GetOffset(topic string, partition int32, time int64) (int64, error)
+	// GroupOffsets returns the committed offsets of a consumer group on the partitions of a topic,
+	// partitions without committed offset will have sarama.OffsetNewest (-1)
+	GroupOffsets(group, topic string, partitions []int32) (map[int32]int64, error)
+	// Close closes the client
+	Close() error
+}
+
+// saramaClient is a kafkaClient implementation based on sarama client
+type saramaClient struct {
+	sarama.Client
+}
+
+// GroupOffsets satisfies kafkaClient interface
+func (s *saramaClient) GroupOffsets(group, topic string, partitions []int32) (map[int32]int64, error) {
+	broker, err := s.Coordinator(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &sarama.OffsetFetchRequest{
+		ConsumerGroup: group,
+		Version:       1,
+	}
+	for _, p := range partitions {
+		req.AddPartition(topic, p)
+	}
+
+	resp, err := broker.FetchOffset(req)
+	if err != nil {
+		// Coordinator could be stale, the next time will be asked again
+		s.RefreshCoordinator(group)
+		return nil, err
+	}
+
+	offsets := make(map[int32]int64, len(partitions))
+	for _, p := range partitions {
+		b := resp.GetBlock(topic, p)
+		if b == nil {
+			return nil, fmt.Errorf("missing offset of partition %d of %s topic", p, topic)
+		}
+		if b.Err != sarama.ErrNoError {
+			if b.Err == sarama.ErrNotCoordinatorForConsumer {
+				s.RefreshCoordinator(group)
+			}
+			return nil, b.Err
+		}
+		offsets[p] = b.Offset
+	}
+
+	return offsets, nil
+}
+
+// KafkaConsumerGroupLag represents an object for gathering the lag of a Kafka consumer group
+type KafkaConsumerGroupLag struct {
+	brokers        []string // Kafka brokers
+	consumerGroup  string   // The consumer group
+	topics         []string // The topics consumed by the group
+	lagAggregation string   // How the partition lags are aggregated (total, max)
+
+	cfg      *sarama.Config // Client configuration
+	client   kafkaClient
+	clientMu sync.Mutex
+	// newClient creates the kafka client, the client is created lazily on the first gathering
+	newClient func(brokers []string, cfg *sarama.Config) (kafkaClient, error)
+	log       *log.Log // custom logger
+}
+
+// kafkaConsumerGroupLagCreator creates the kafka consumer group lag gatherer creator
+type kafkaConsumerGroupLagCreator struct{}
+
+func (k *kafkaConsumerGroupLagCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewKafkaConsumerGroupLag(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(kfkRegName, &kafkaConsumerGroupLagCreator{})
+}
+
+// newSaramaClient creates a new kafkaClient using sarama
+func newSaramaClient(brokers []string, cfg *sarama.Config) (kafkaClient, error) {
+	c, err := sarama.NewClient(brokers, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &saramaClient{c}, nil
+}
+
+// NewKafkaConsumerGroupLag creates a Kafka consumer group lag gatherer
+func NewKafkaConsumerGroupLag(ctx context.Context, opts map[string]interface{}) (k *KafkaConsumerGroupLag, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	k = &KafkaConsumerGroupLag{
+		lagAggregation: kfkLagTotal,
+		newClient:      newSaramaClient,
+	}
+
+	var ok bool
+
+	// interfaces and arrays stuff conversion
+	bsI, _ := opts[kfkBrokersOpt].([]interface{})
+	if len(bsI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", kfkBrokersOpt)
+	}
+	k.brokers = make([]string, len(bsI))
+	for i, b := range bsI {
+		k.brokers[i] = b.(string)
+	}
+
+	tsI, _ := opts[kfkTopicsOpt].([]interface{})
+	if len(tsI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", kfkTopicsOpt)
+	}
+	k.topics = make([]string, len(tsI))
+	for i, t := range tsI {
+		k.topics[i] = t.(string)
+	}
+
+	if k.consumerGroup, ok = opts[kfkConsumerGroupOpt].(string); !ok || k.consumerGroup == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", kfkConsumerGroupOpt)
+	}
+
+	if v, ok := opts[kfkLagAggregationOpt]; ok {
+		k.lagAggregation = v.(string)
+	}
+	switch k.lagAggregation {
+	case kfkLagTotal, kfkLagMax:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", kfkLagAggregationOpt)
+	}
+
+	// Client configuration
+	k.cfg = sarama.NewConfig()
+	k.cfg.ClientID = kfkClientID
+
+	if v, ok := opts[kfkVersionOpt]; ok {
+		if k.cfg.Version, err = sarama.ParseKafkaVersion(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", kfkVersionOpt, err)
+		}
+	}
+
+	// SASL
+	if v, ok := opts[kfkSASLUsernameOpt]; ok {
+		k.cfg.Net.SASL.Enable = true
+		k.cfg.Net.SASL.User = v.(string)
+		if v, ok := opts[kfkSASLPasswordOpt]; ok {
+			k.cfg.Net.SASL.Password = v.(string)
+		}
+	}
+
+	// TLS
+	if v, ok := opts[kfkTLSOpt]; ok && v.(bool) {
+		var caFile, certFile, keyFile string
+		var insecure bool
+		if v, ok := opts[kfkCAFileOpt]; ok {
+			caFile = v.(string)
+		}
+		if v, ok := opts[kfkCertFileOpt]; ok {
+			certFile = v.(string)
+		}
+		if v, ok := opts[kfkKeyFileOpt]; ok {
+			keyFile = v.(string)
+		}
+		if v, ok := opts[kfkInsecureSkipVerifyOpt]; ok {
+			insecure = v.(bool)
+		}
+
+		tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", kfkCAFileOpt, err)
+		}
+		if certFile != "" || keyFile != "" {
+			c, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("%s or %s configuration opts are wrong: %s", kfkCertFileOpt, kfkKeyFileOpt, err)
+			}
+			tlsCfg.Certificates = []tls.Certificate{c}
+		}
+
+		k.cfg.Net.TLS.Enable = true
+		k.cfg.Net.TLS.Config = tlsCfg
+	}
+
+	if err := k.cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	k.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       kfkRegName,
+	})
+
+	return
+}
+
+// getClient returns the kafka client, it will connect to the brokers the first time
+func (k *KafkaConsumerGroupLag) getClient() (kafkaClient, error) {
+	k.clientMu.Lock()
+	defer k.clientMu.Unlock()
+
+	if k.client == nil {
+		k.log.Debugf("Connecting to kafka brokers")
+		c, err := k.newClient(k.brokers, k.cfg)
+		if err != nil {
+			return nil, err
+		}
+		k.client = c
+	}
+	return k.client, nil
+}
+
+// topicLags returns the lag of each partition of a topic
+func (k *KafkaConsumerGroupLag) topicLags(c kafkaClient, topic string) ([]int64, error) {
+	partitions, err := c.Partitions(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	committed, err := c.GroupOffsets(k.consumerGroup, topic, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	lags := make([]int64, len(partitions))
+	for i, p := range partitions {
+		newest, err := c.GetOffset(topic, p, sarama.OffsetNewest)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the group didn't commit on this partition then all the available messages are lag
+		offset, ok := committed[p]
+		if !ok || offset < 0 {
+			if offset, err = c.GetOffset(topic, p, sarama.OffsetOldest); err != nil {
+				return nil, err
+			}
+		}
+
+		lag := newest - offset
+		if lag < 0 {
+			lag = 0
+		}
+		lags[i] = lag
+	}
+
+	return lags, nil
+}
+
+// Gather returns the lag of the consumer group on the topics
+func (k *KafkaConsumerGroupLag) Gather(_ context.Context) (types.Quantity, error) {
+	q := types.Quantity{Q: 0}
+
+	c, err := k.getClient()
+	if err != nil {
+		return q, err
+	}
+
+	for _, t := range k.topics {
+		k.log.Debugf("Gathering '%s' consumer group lag on '%s' topic", k.consumerGroup, t)
+		lags, err := k.topicLags(c, t)
+		if err != nil {
+			return types.Quantity{}, err
+		}
+
+		for _, l := range lags {
+			switch k.lagAggregation {
+			case kfkLagTotal:
+				q.Q += l
+			case kfkLagMax:
+				if l > q.Q {
+					q.Q = l
+				}
+			}
+		}
+	}
+
+	k.log.Debugf("Retrieved kafka consumer group lag input: %s", q)
+
+	return q, nil
+}
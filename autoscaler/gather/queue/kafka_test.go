@@ -0,0 +1,266 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/themotion/ladder/log"
+)
+
+func TestKafkaConsumerGroupLagCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantAggregation string
+		wantError       bool
+	}{
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:       []interface{}{"127.0.0.1:9092", "127.0.0.2:9092"},
+				kfkTopicsOpt:        []interface{}{"jobs"},
+				kfkConsumerGroupOpt: "workers",
+			},
+			wantAggregation: kfkLagTotal,
+		},
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:        []interface{}{"127.0.0.1:9092"},
+				kfkTopicsOpt:         []interface{}{"jobs", "renders"},
+				kfkConsumerGroupOpt:  "workers",
+				kfkLagAggregationOpt: "max",
+				kfkVersionOpt:        "0.10.2.0",
+				kfkSASLUsernameOpt:   "ladder",
+				kfkSASLPasswordOpt:   "secret",
+				kfkTLSOpt:            true,
+			},
+			wantAggregation: kfkLagMax,
+		},
+		// Missing brokers
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:       []interface{}{},
+				kfkTopicsOpt:        []interface{}{"jobs"},
+				kfkConsumerGroupOpt: "workers",
+			},
+			wantError: true,
+		},
+		// Missing topics
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:       []interface{}{"127.0.0.1:9092"},
+				kfkConsumerGroupOpt: "workers",
+			},
+			wantError: true,
+		},
+		// Missing consumer group
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt: []interface{}{"127.0.0.1:9092"},
+				kfkTopicsOpt:  []interface{}{"jobs"},
+			},
+			wantError: true,
+		},
+		// Wrong aggregation
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:        []interface{}{"127.0.0.1:9092"},
+				kfkTopicsOpt:         []interface{}{"jobs"},
+				kfkConsumerGroupOpt:  "workers",
+				kfkLagAggregationOpt: "avg",
+			},
+			wantError: true,
+		},
+		// Wrong version
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:       []interface{}{"127.0.0.1:9092"},
+				kfkTopicsOpt:        []interface{}{"jobs"},
+				kfkConsumerGroupOpt: "workers",
+				kfkVersionOpt:       "latest",
+			},
+			wantError: true,
+		},
+		// Wrong certificates
+		{
+			opts: map[string]interface{}{
+				kfkBrokersOpt:       []interface{}{"127.0.0.1:9092"},
+				kfkTopicsOpt:        []interface{}{"jobs"},
+				kfkConsumerGroupOpt: "workers",
+				kfkTLSOpt:           true,
+				kfkCertFileOpt:      "/tmp/does/not/exist.crt",
+				kfkKeyFileOpt:       "/tmp/does/not/exist.key",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		k, err := NewKafkaConsumerGroupLag(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if k.lagAggregation != test.wantAggregation {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantAggregation, k.lagAggregation)
+		}
+
+		if k.client != nil {
+			t.Errorf("\n- %+v\n  Client shouldn't connect on creation", test)
+		}
+	}
+}
+
+// fakeKafkaClient is an in-process fake of the kafka client
+type fakeKafkaClient struct {
+	newest    map[string][]int64 // newest offsets per topic partition
+	oldest    map[string][]int64 // oldest offsets per topic partition
+	committed map[string][]int64 // committed offsets per topic partition
+	wantError bool
+}
+
+func (f *fakeKafkaClient) Partitions(topic string) ([]int32, error) {
+	if f.wantError {
+		return nil, errors.New("Wrong!")
+	}
+	ps := []int32{}
+	for i := range f.newest[topic] {
+		ps = append(ps, int32(i))
+	}
+	return ps, nil
+}
+
+func (f *fakeKafkaClient) GetOffset(topic string, partition int32, time int64) (int64, error) {
+	if time == sarama.OffsetOldest {
+		return f.oldest[topic][partition], nil
+	}
+	return f.newest[topic][partition], nil
+}
+
+func (f *fakeKafkaClient) GroupOffsets(group, topic string, partitions []int32) (map[int32]int64, error) {
+	res := map[int32]int64{}
+	for _, p := range partitions {
+		res[p] = f.committed[topic][p]
+	}
+	return res, nil
+}
+
+func (f *fakeKafkaClient) Close() error { return nil }
+
+func TestKafkaConsumerGroupLagGather(t *testing.T) {
+	client := &fakeKafkaClient{
+		newest: map[string][]int64{
+			"jobs":    {100, 200, 300},
+			"renders": {50, 60},
+		},
+		oldest: map[string][]int64{
+			"jobs":    {0, 0, 0},
+			"renders": {10, 10},
+		},
+		committed: map[string][]int64{
+			"jobs":    {90, 150, 300},
+			"renders": {-1, 65},
+		},
+	}
+
+	tests := []struct {
+		topics      []string
+		aggregation string
+		clientError bool
+
+		wantQ     int64
+		wantError bool
+	}{
+		{[]string{"jobs"}, kfkLagTotal, false, 60, false},
+		{[]string{"jobs"}, kfkLagMax, false, 50, false},
+		// Not committed partitions have all the messages as lag, negative lags are 0
+		{[]string{"renders"}, kfkLagTotal, false, 40, false},
+		{[]string{"jobs", "renders"}, kfkLagTotal, false, 100, false},
+		{[]string{"jobs", "renders"}, kfkLagMax, false, 50, false},
+		{[]string{"jobs"}, kfkLagTotal, true, 0, true},
+	}
+
+	for _, test := range tests {
+		c := *client
+		c.wantError = test.clientError
+		k := &KafkaConsumerGroupLag{
+			consumerGroup:  "workers",
+			topics:         test.topics,
+			lagAggregation: test.aggregation,
+			newClient: func(brokers []string, cfg *sarama.Config) (kafkaClient, error) {
+				return &c, nil
+			},
+			log: log.New(),
+		}
+
+		q, err := k.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
+
+func TestKafkaConsumerGroupLagGatherMockBroker(t *testing.T) {
+	// Local broker stand-in
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("jobs", 0, broker.BrokerID()).
+			SetLeader("jobs", 1, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset("jobs", 0, sarama.OffsetNewest, 1000).
+			SetOffset("jobs", 0, sarama.OffsetOldest, 0).
+			SetOffset("jobs", 1, sarama.OffsetNewest, 2000).
+			SetOffset("jobs", 1, sarama.OffsetOldest, 1500),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "workers", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("workers", "jobs", 0, 900, "", sarama.ErrNoError).
+			SetOffset("workers", "jobs", 1, -1, "", sarama.ErrNoError),
+	})
+
+	k, err := NewKafkaConsumerGroupLag(context.TODO(), map[string]interface{}{
+		kfkBrokersOpt:       []interface{}{broker.Addr()},
+		kfkTopicsOpt:        []interface{}{"jobs"},
+		kfkConsumerGroupOpt: "workers",
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+
+	q, err := k.Gather(context.TODO())
+	if err != nil {
+		t.Fatalf("Gather shouldn't give error: %v", err)
+	}
+	k.client.Close()
+
+	// 100 from partition 0 and 500 from not committed partition 1
+	if q.Q != 600 {
+		t.Errorf("Wrong gathering retrieved value, want: %v; got %v", 600, q.Q)
+	}
+}
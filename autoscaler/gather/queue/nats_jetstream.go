@@ -0,0 +1,239 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+const (
+	// Opts
+	njsServersOpt            = "servers"
+	njsStreamOpt             = "stream"
+	njsConsumerOpt           = "consumer"
+	njsConsumersOpt          = "consumers"
+	njsUsernameOpt           = "username"
+	njsPasswordOpt           = "password"
+	njsTokenOpt              = "token"
+	njsCredentialsFileOpt    = "credentials_file"
+	njsTLSOpt                = "tls"
+	njsCAFileOpt             = "ca_file"
+	njsInsecureSkipVerifyOpt = "insecure_skip_verify"
+	njsTimeoutOpt            = "timeout"
+
+	// Defaults
+	njsDefaultTimeout = 10 * time.Second
+
+	// Client name used when connecting to the servers
+	njsClientName = "ladder"
+
+	// the name
+	njsRegName = "nats_jetstream"
+)
+
+// NATSJetStream represents an object for gathering the pending messages of JetStream durable
+// consumers, if more than one consumer is set the result will be the sum of all of them
+type NATSJetStream struct {
+	servers   []string // NATS servers
+	stream    string   // The stream name
+	consumers []string // The durable consumer names
+	timeout   time.Duration
+
+	opts   []nats.Option // Connection options
+	jsOpts []nats.JSOpt  // JetStream context options
+
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	connMu sync.Mutex
+	log    *log.Log // custom logger
+}
+
+// natsJetStreamCreator creates the NATS JetStream gatherer creator
+type natsJetStreamCreator struct{}
+
+func (n *natsJetStreamCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewNATSJetStream(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(njsRegName, &natsJetStreamCreator{})
+}
+
+// NewNATSJetStream creates a NATS JetStream consumer pending gatherer
+func NewNATSJetStream(ctx context.Context, opts map[string]interface{}) (n *NATSJetStream, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	n = &NATSJetStream{}
+
+	var ok bool
+
+	// interfaces and arrays stuff conversion
+	ssI, _ := opts[njsServersOpt].([]interface{})
+	if len(ssI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", njsServersOpt)
+	}
+	n.servers = make([]string, len(ssI))
+	for i, s := range ssI {
+		n.servers[i] = s.(string)
+	}
+
+	if n.stream, ok = opts[njsStreamOpt].(string); !ok || n.stream == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", njsStreamOpt)
+	}
+
+	// Consumers, at least one of them is required
+	if v, ok := opts[njsConsumerOpt]; ok {
+		n.consumers = append(n.consumers, v.(string))
+	}
+	if v, ok := opts[njsConsumersOpt]; ok {
+		for _, c := range v.([]interface{}) {
+			n.consumers = append(n.consumers, c.(string))
+		}
+	}
+	if len(n.consumers) == 0 {
+		return nil, fmt.Errorf("%s or %s configuration opt is required", njsConsumerOpt, njsConsumersOpt)
+	}
+	for _, c := range n.consumers {
+		if c == "" {
+			return nil, fmt.Errorf("%s configuration opt is wrong: consumer names can't be empty", njsConsumersOpt)
+		}
+	}
+
+	// Connection options
+	timeout := njsDefaultTimeout
+	if v, ok := opts[njsTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", njsTimeoutOpt, err)
+		}
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s should be positive", njsTimeoutOpt)
+	}
+	n.timeout = timeout
+	n.opts = []nats.Option{
+		nats.Name(njsClientName),
+		nats.Timeout(timeout),
+	}
+	n.jsOpts = []nats.JSOpt{nats.MaxWait(timeout)}
+
+	// Auth
+	var username, token, credentials string
+	if v, ok := opts[njsUsernameOpt]; ok {
+		username = v.(string)
+	}
+	if v, ok := opts[njsTokenOpt]; ok {
+		token = v.(string)
+	}
+	if v, ok := opts[njsCredentialsFileOpt]; ok {
+		credentials = v.(string)
+	}
+	switch {
+	case username != "" && token != "", username != "" && credentials != "", token != "" && credentials != "":
+		return nil, fmt.Errorf("only one of %s, %s or %s configuration opts can be used", njsUsernameOpt, njsTokenOpt, njsCredentialsFileOpt)
+	case username != "":
+		var password string
+		if v, ok := opts[njsPasswordOpt]; ok {
+			password = v.(string)
+		}
+		n.opts = append(n.opts, nats.UserInfo(username, password))
+	case token != "":
+		n.opts = append(n.opts, nats.Token(token))
+	case credentials != "":
+		n.opts = append(n.opts, nats.UserCredentials(credentials))
+	}
+
+	// TLS
+	if v, ok := opts[njsTLSOpt]; ok && v.(bool) {
+		var caFile string
+		var insecure bool
+		if v, ok := opts[njsCAFileOpt]; ok {
+			caFile = v.(string)
+		}
+		if v, ok := opts[njsInsecureSkipVerifyOpt]; ok {
+			insecure = v.(bool)
+		}
+		tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", njsCAFileOpt, err)
+		}
+		n.opts = append(n.opts, nats.Secure(tlsCfg))
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	n.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       njsRegName,
+	})
+
+	return
+}
+
+// getJetStream returns the JetStream context, it will connect to the servers the first time,
+// after that the connection will reconnect by itself
+func (n *NATSJetStream) getJetStream() (nats.JetStreamContext, error) {
+	n.connMu.Lock()
+	defer n.connMu.Unlock()
+
+	if n.conn == nil || n.conn.IsClosed() {
+		n.log.Debugf("Connecting to nats servers")
+		c, err := nats.Connect(strings.Join(n.servers, ","), n.opts...)
+		if err != nil {
+			return nil, err
+		}
+		js, err := c.JetStream(n.jsOpts...)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		n.conn = c
+		n.js = js
+	}
+	return n.js, nil
+}
+
+// Gather returns the sum of the pending messages of all the consumers, the pending messages
+// are the ones not delivered yet plus the ones delivered but not acknowledged
+func (n *NATSJetStream) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{Q: 0}
+
+	js, err := n.getJetStream()
+	if err != nil {
+		return q, err
+	}
+
+	for _, c := range n.consumers {
+		n.log.Debugf("Gathering '%s' consumer pending messages on '%s' stream", c, n.stream)
+		// When using a context the JetStream max wait is ignored, set the timeout on the context
+		cctx, cancel := context.WithTimeout(ctx, n.timeout)
+		info, err := js.ConsumerInfo(n.stream, c, nats.Context(cctx))
+		cancel()
+		if err != nil {
+			return types.Quantity{}, fmt.Errorf("error gathering '%s' consumer: %s", c, err)
+		}
+		q.Q += int64(info.NumPending) + int64(info.NumAckPending)
+	}
+
+	n.log.Debugf("Retrieved nats jetstream input: %s", q)
+
+	return q, nil
+}
@@ -0,0 +1,217 @@
+//go:build integration
+// +build integration
+
+package queue
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// runJetStreamServer runs an embedded NATS server with JetStream enabled, the
+// server is not vendored so it needs to be on the GOPATH
+func runJetStreamServer(t *testing.T) (*server.Server, func()) {
+	dir, err := ioutil.TempDir("", "ladder-jetstream")
+	if err != nil {
+		t.Fatalf("Error creating jetstream store dir: %v", err)
+	}
+
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  dir,
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("Error creating nats server: %v", err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		s.Shutdown()
+		os.RemoveAll(dir)
+		t.Fatalf("Error starting nats server")
+	}
+
+	return s, func() {
+		s.Shutdown()
+		s.WaitForShutdown()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestNATSJetStreamGather(t *testing.T) {
+	s, stop := runJetStreamServer(t)
+	defer stop()
+
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("Error connecting to nats: %v", err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatalf("Error preparing jetstream: %v", err)
+	}
+
+	// 10 jobs on the stream
+	if _, err := js.AddStream(&nats.StreamConfig{Name: "JOBS", Subjects: []string{"jobs.>"}}); err != nil {
+		t.Fatalf("Error preparing stream: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := js.Publish("jobs.render", []byte("render")); err != nil {
+			t.Fatalf("Error preparing stream: %v", err)
+		}
+	}
+
+	// renderers: 3 delivered without ack (7 pending + 3 ack pending)
+	// uploaders: 4 delivered and acked (6 pending)
+	// encoders: nothing delivered (10 pending)
+	for _, c := range []string{"renderers", "uploaders", "encoders"} {
+		if _, err := js.AddConsumer("JOBS", &nats.ConsumerConfig{Durable: c, AckPolicy: nats.AckExplicitPolicy}); err != nil {
+			t.Fatalf("Error preparing consumers: %v", err)
+		}
+	}
+	fetch := func(consumer string, n int, ack bool) {
+		sub, err := js.PullSubscribe("jobs.>", consumer, nats.Bind("JOBS", consumer))
+		if err != nil {
+			t.Fatalf("Error preparing consumers: %v", err)
+		}
+		msgs, err := sub.Fetch(n)
+		if err != nil || len(msgs) != n {
+			t.Fatalf("Error preparing consumers: %v", err)
+		}
+		for _, m := range msgs {
+			if ack {
+				if err := m.AckSync(); err != nil {
+					t.Fatalf("Error preparing consumers: %v", err)
+				}
+			}
+		}
+	}
+	fetch("renderers", 3, false)
+	fetch("uploaders", 4, true)
+
+	tests := []struct {
+		stream    string
+		consumers []interface{}
+
+		wantQ     int64
+		wantError bool
+	}{
+		{"JOBS", []interface{}{"renderers"}, 10, false},
+		{"JOBS", []interface{}{"uploaders"}, 6, false},
+		{"JOBS", []interface{}{"encoders"}, 10, false},
+		{"JOBS", []interface{}{"renderers", "uploaders", "encoders"}, 26, false},
+		// Missing consumers and streams are bad
+		{"JOBS", []interface{}{"renderers", "missing"}, 0, true},
+		{"MISSING", []interface{}{"renderers"}, 0, true},
+	}
+
+	for _, test := range tests {
+		n, err := NewNATSJetStream(context.TODO(), map[string]interface{}{
+			njsServersOpt:   []interface{}{s.ClientURL()},
+			njsStreamOpt:    test.stream,
+			njsConsumersOpt: test.consumers,
+			njsTimeoutOpt:   "2s",
+		})
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+
+		q, err := n.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
+
+func TestNATSJetStreamGatherConnectionError(t *testing.T) {
+	s, stop := runJetStreamServer(t)
+	url := s.ClientURL()
+	stop()
+
+	n, err := NewNATSJetStream(context.TODO(), map[string]interface{}{
+		njsServersOpt:  []interface{}{url},
+		njsStreamOpt:   "JOBS",
+		njsConsumerOpt: "renderers",
+		njsTimeoutOpt:  "1s",
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+
+	if _, err := n.Gather(context.TODO()); err == nil {
+		t.Errorf("Gather should give error, it didn't")
+	}
+}
+
+func TestNATSJetStreamGatherTimeout(t *testing.T) {
+	// A server whose JetStream API receives the requests but never answers
+	s, err := server.NewServer(&server.Options{
+		Host:   "127.0.0.1",
+		Port:   server.RANDOM_PORT,
+		NoLog:  true,
+		NoSigs: true,
+	})
+	if err != nil {
+		t.Fatalf("Error creating nats server: %v", err)
+	}
+	go s.Start()
+	defer s.Shutdown()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatalf("Error starting nats server")
+	}
+
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("Error connecting to nats server: %v", err)
+	}
+	defer nc.Close()
+	if _, err := nc.Subscribe("$JS.API.>", func(m *nats.Msg) {}); err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	nc.Flush()
+
+	n, err := NewNATSJetStream(context.TODO(), map[string]interface{}{
+		njsServersOpt:   []interface{}{s.ClientURL()},
+		njsStreamOpt:    "JOBS",
+		njsConsumersOpt: []interface{}{"renderers", "uploaders"},
+		njsTimeoutOpt:   "200ms",
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+
+	// The autoscaler iteration context doesn't have deadline
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := time.Now()
+	if _, err := n.Gather(ctx); err == nil {
+		t.Errorf("Gather should give error, it didn't")
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Errorf("Gather should timeout, it took %s", time.Since(start))
+	}
+}
@@ -0,0 +1,121 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestNATSJetStreamCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantConsumers []string
+		wantError     bool
+	}{
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:  []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:   "JOBS",
+				njsConsumerOpt: "renderers",
+			},
+			wantConsumers: []string{"renderers"},
+		},
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:   []interface{}{"nats://nats1:4222", "nats://nats2:4222"},
+				njsStreamOpt:    "JOBS",
+				njsConsumerOpt:  "renderers",
+				njsConsumersOpt: []interface{}{"uploaders", "encoders"},
+				njsUsernameOpt:  "ladder",
+				njsPasswordOpt:  "secret",
+				njsTimeoutOpt:   "2s",
+				njsTLSOpt:       true,
+			},
+			wantConsumers: []string{"renderers", "uploaders", "encoders"},
+		},
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:   []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:    "JOBS",
+				njsConsumersOpt: []interface{}{"renderers"},
+				njsTokenOpt:     "secret",
+			},
+			wantConsumers: []string{"renderers"},
+		},
+		// Missing servers
+		{
+			opts: map[string]interface{}{
+				njsStreamOpt:   "JOBS",
+				njsConsumerOpt: "renderers",
+			},
+			wantError: true,
+		},
+		// Missing stream
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:  []interface{}{"nats://127.0.0.1:4222"},
+				njsConsumerOpt: "renderers",
+			},
+			wantError: true,
+		},
+		// Missing consumers
+		{
+			opts: map[string]interface{}{
+				njsServersOpt: []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:  "JOBS",
+			},
+			wantError: true,
+		},
+		// Empty consumer
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:   []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:    "JOBS",
+				njsConsumersOpt: []interface{}{"renderers", ""},
+			},
+			wantError: true,
+		},
+		// Multiple auths
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:  []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:   "JOBS",
+				njsConsumerOpt: "renderers",
+				njsUsernameOpt: "ladder",
+				njsTokenOpt:    "secret",
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				njsServersOpt:  []interface{}{"nats://127.0.0.1:4222"},
+				njsStreamOpt:   "JOBS",
+				njsConsumerOpt: "renderers",
+				njsTimeoutOpt:  "10",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		n, err := NewNATSJetStream(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if fmt.Sprint(n.consumers) != fmt.Sprint(test.wantConsumers) {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantConsumers, n.consumers)
+		}
+	}
+}
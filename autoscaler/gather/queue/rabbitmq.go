@@ -0,0 +1,263 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+const (
+	// Opts
+	rmqAddressOpt            = "address"
+	rmqVhostOpt              = "vhost"
+	rmqQueuesOpt             = "queues"
+	rmqQueuesNameOpt         = "name"
+	rmqQueuesVhostOpt        = "vhost"
+	rmqQueuePropertyOpt      = "queue_property"
+	rmqUsernameOpt           = "username"
+	rmqPasswordOpt           = "password"
+	rmqCAFileOpt             = "ca_file"
+	rmqInsecureSkipVerifyOpt = "insecure_skip_verify"
+	rmqTimeoutOpt            = "timeout"
+
+	// Queue properties
+	rmqPropMessages               = "messages"
+	rmqPropMessagesReady          = "messages_ready"
+	rmqPropMessagesUnacknowledged = "messages_unacknowledged"
+	rmqPropConsumers              = "consumers"
+
+	// Defaults
+	rmqDefaultVhost   = "/"
+	rmqDefaultTimeout = 10 * time.Second
+
+	// the name
+	rmqRegName = "rabbitmq_queue"
+)
+
+// rmqQueue is a queue of a vhost
+type rmqQueue struct {
+	vhost string
+	name  string
+}
+
+// rmqQueueInfo is the queue information returned by the management API
+type rmqQueueInfo struct {
+	Messages               int64 `json:"messages"`
+	MessagesReady          int64 `json:"messages_ready"`
+	MessagesUnacknowledged int64 `json:"messages_unacknowledged"`
+	Consumers              int64 `json:"consumers"`
+}
+
+// RabbitMQQueue represents an object for gathering inputs from RabbitMQ queues
+// using the management HTTP API, if more than one queue is set the result will be
+// the sum of the property of all the queues
+type RabbitMQQueue struct {
+	address       string     // management API address
+	queues        []rmqQueue // queues to gather
+	queueProperty string     // The queue property where we will get the information
+	username      string     // basic auth username
+	password      string     // basic auth password
+
+	client *http.Client
+	log    *log.Log // custom logger
+}
+
+// rabbitMQQueueCreator creates the rabbitmq queue gatherer creator
+type rabbitMQQueueCreator struct{}
+
+func (r *rabbitMQQueueCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewRabbitMQQueue(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(rmqRegName, &rabbitMQQueueCreator{})
+}
+
+// NewRabbitMQQueue creates a RabbitMQ queue gatherer
+func NewRabbitMQQueue(ctx context.Context, opts map[string]interface{}) (r *RabbitMQQueue, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if rc := recover(); rc != nil {
+			err = fmt.Errorf("%v", rc)
+		}
+	}()
+
+	r = &RabbitMQQueue{}
+
+	// Prepare ops
+	var ok bool
+
+	// Set each option with the correct type
+	if r.address, ok = opts[rmqAddressOpt].(string); !ok || r.address == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", rmqAddressOpt)
+	}
+	if _, err = url.Parse(r.address); err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", rmqAddressOpt, err)
+	}
+	r.address = strings.TrimRight(r.address, "/")
+
+	// Default vhost for the queues
+	vhost := rmqDefaultVhost
+	if v, ok := opts[rmqVhostOpt]; ok {
+		vhost = v.(string)
+	}
+
+	// type assertions of the queues conf
+	qsI, ok := opts[rmqQueuesOpt].([]interface{})
+	if !ok || len(qsI) == 0 {
+		return nil, fmt.Errorf("%s configuration opt is required", rmqQueuesOpt)
+	}
+
+	r.queues = make([]rmqQueue, len(qsI))
+	for i, qI := range qsI {
+		q := qI.(map[interface{}]interface{})
+		n, ok := q[rmqQueuesNameOpt].(string)
+		if !ok || n == "" {
+			return nil, fmt.Errorf("%s configuration opt is invalid, queue name is required", rmqQueuesOpt)
+		}
+		vh := vhost
+		if v, ok := q[rmqQueuesVhostOpt]; ok {
+			vh = v.(string)
+		}
+		r.queues[i] = rmqQueue{vhost: vh, name: n}
+	}
+
+	if r.queueProperty, ok = opts[rmqQueuePropertyOpt].(string); !ok || r.queueProperty == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", rmqQueuePropertyOpt)
+	}
+
+	// Check queue property correct
+	switch r.queueProperty {
+	case rmqPropMessages,
+		rmqPropMessagesReady,
+		rmqPropMessagesUnacknowledged,
+		rmqPropConsumers:
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", rmqQueuePropertyOpt)
+	}
+
+	// Auth
+	if v, ok := opts[rmqUsernameOpt]; ok {
+		r.username = v.(string)
+	}
+	if v, ok := opts[rmqPasswordOpt]; ok {
+		r.password = v.(string)
+	}
+
+	// TLS
+	var caFile string
+	var insecure bool
+	if v, ok := opts[rmqCAFileOpt]; ok {
+		caFile = v.(string)
+	}
+	if v, ok := opts[rmqInsecureSkipVerifyOpt]; ok {
+		insecure = v.(bool)
+	}
+	tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("%s configuration opt is wrong: %s", rmqCAFileOpt, err)
+	}
+
+	// Timeout
+	timeout := rmqDefaultTimeout
+	if v, ok := opts[rmqTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", rmqTimeoutOpt, err)
+		}
+	}
+
+	r.client = utilhttp.NewClient(timeout, tlsCfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	r.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       rmqRegName,
+	})
+
+	return
+}
+
+// queueInfo gets the information of a queue from the management API
+func (r *RabbitMQQueue) queueInfo(ctx context.Context, q rmqQueue) (*rmqQueueInfo, error) {
+	u, err := url.Parse(r.address)
+	if err != nil {
+		return nil, err
+	}
+	// vhosts usually have slashes, they need to be escaped
+	u.RawPath = fmt.Sprintf("%s/api/queues/%s/%s", u.Path, url.PathEscape(q.vhost), url.PathEscape(q.name))
+	u.Path = fmt.Sprintf("%s/api/queues/%s/%s", u.Path, q.vhost, q.name)
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.username != "" {
+		req.SetBasicAuth(r.username, r.password)
+	}
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rabbitmq management API returned %d status code for queue '%s' on vhost '%s'", resp.StatusCode, q.name, q.vhost)
+	}
+
+	info := &rmqQueueInfo{}
+	if err := json.Unmarshal(b, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Gather retrieves the RabbitMQ queues information and returns the sum of the desired property
+func (r *RabbitMQQueue) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{Q: 0}
+
+	for _, qu := range r.queues {
+		r.log.Debugf("Gathering '%s' queue (vhost '%s') information from RabbitMQ", qu.name, qu.vhost)
+		info, err := r.queueInfo(ctx, qu)
+		if err != nil {
+			return types.Quantity{}, err
+		}
+
+		switch r.queueProperty {
+		case rmqPropMessages:
+			q.Q += info.Messages
+		case rmqPropMessagesReady:
+			q.Q += info.MessagesReady
+		case rmqPropMessagesUnacknowledged:
+			q.Q += info.MessagesUnacknowledged
+		case rmqPropConsumers:
+			q.Q += info.Consumers
+		}
+	}
+
+	r.log.Debugf("Retrieved rabbitmq input: %s", q)
+
+	return q, nil
+}
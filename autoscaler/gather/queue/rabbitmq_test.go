@@ -0,0 +1,209 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/themotion/ladder/log"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+func TestRabbitMQQueueCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantQueues []rmqQueue
+		wantError  bool
+	}{
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "messages",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+				},
+			},
+			wantQueues: []rmqQueue{{"/", "jobs"}},
+		},
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "https://127.0.0.1:15671",
+				rmqVhostOpt:         "production",
+				rmqQueuePropertyOpt: "consumers",
+				rmqUsernameOpt:      "guest",
+				rmqPasswordOpt:      "guest",
+				rmqTimeoutOpt:       "2s",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs", rmqQueuesVhostOpt: "staging"},
+				},
+			},
+			wantQueues: []rmqQueue{{"production", "jobs"}, {"staging", "jobs"}},
+		},
+		// Missing address
+		{
+			opts: map[string]interface{}{
+				rmqQueuePropertyOpt: "messages",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+				},
+			},
+			wantError: true,
+		},
+		// Missing queues
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "messages",
+				rmqQueuesOpt:        []interface{}{},
+			},
+			wantError: true,
+		},
+		// Missing queue name
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "messages",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesVhostOpt: "/"},
+				},
+			},
+			wantError: true,
+		},
+		// Wrong property
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "message_stats",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+				},
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "messages",
+				rmqTimeoutOpt:       "2",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+				},
+			},
+			wantError: true,
+		},
+		// Missing CA file
+		{
+			opts: map[string]interface{}{
+				rmqAddressOpt:       "http://127.0.0.1:15672",
+				rmqQueuePropertyOpt: "messages",
+				rmqCAFileOpt:        "/tmp/does/not/exist",
+				rmqQueuesOpt: []interface{}{
+					map[interface{}]interface{}{rmqQueuesNameOpt: "jobs"},
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		r, err := NewRabbitMQQueue(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if len(r.queues) != len(test.wantQueues) {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, test.wantQueues, r.queues)
+			continue
+		}
+		for i, q := range test.wantQueues {
+			if r.queues[i] != q {
+				t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v; got %v", test, q, r.queues[i])
+			}
+		}
+	}
+}
+
+func TestRabbitMQQueueGather(t *testing.T) {
+	// Our RabbitMQ management API stand-in
+	queues := map[string]string{
+		"/api/queues/%2F/jobs":           `{"name":"jobs","vhost":"/","messages":15,"messages_ready":10,"messages_unacknowledged":5,"consumers":3}`,
+		"/api/queues/production/jobs":    `{"name":"jobs","vhost":"production","messages":150,"messages_ready":100,"messages_unacknowledged":50,"consumers":30}`,
+		"/api/queues/production/renders": `{"name":"renders","vhost":"production","messages":7,"messages_ready":4,"messages_unacknowledged":3,"consumers":1}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		if !ok || u != "guest" || p != "guest" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		q, ok := queues[r.URL.EscapedPath()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Object Not Found","reason":"Not Found"}`)
+			return
+		}
+		fmt.Fprint(w, q)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		queues   []rmqQueue
+		property string
+		username string
+
+		wantQ     int64
+		wantError bool
+	}{
+		{[]rmqQueue{{"/", "jobs"}}, rmqPropMessages, "guest", 15, false},
+		{[]rmqQueue{{"/", "jobs"}}, rmqPropMessagesReady, "guest", 10, false},
+		{[]rmqQueue{{"/", "jobs"}}, rmqPropMessagesUnacknowledged, "guest", 5, false},
+		{[]rmqQueue{{"/", "jobs"}}, rmqPropConsumers, "guest", 3, false},
+		{[]rmqQueue{{"/", "jobs"}, {"production", "jobs"}, {"production", "renders"}}, rmqPropMessages, "guest", 172, false},
+		{[]rmqQueue{{"production", "jobs"}, {"production", "renders"}}, rmqPropConsumers, "guest", 31, false},
+		{[]rmqQueue{{"/", "jobs"}, {"staging", "jobs"}}, rmqPropMessages, "guest", 0, true},
+		{[]rmqQueue{{"/", "jobs"}}, rmqPropMessages, "wrong", 0, true},
+	}
+
+	for _, test := range tests {
+		tlsCfg, _ := utilhttp.TLSConfig("", false)
+		r := &RabbitMQQueue{
+			address:       ts.URL,
+			queues:        test.queues,
+			queueProperty: test.property,
+			username:      test.username,
+			password:      "guest",
+			client:        utilhttp.NewClient(rmqDefaultTimeout, tlsCfg),
+			log:           log.New(),
+		}
+
+		q, err := r.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+	utilhttp "github.com/themotion/ladder/util/http"
+)
+
+const (
+	// Opts
+	rdsAddressOpt            = "address"
+	rdsPasswordOpt           = "password"
+	rdsDBOpt                 = "db"
+	rdsKeysOpt               = "keys"
+	rdsKeyPatternOpt         = "key_pattern"
+	rdsKeyTypeOpt            = "key_type"
+	rdsGroupOpt              = "group"
+	rdsMinOpt                = "min"
+	rdsMaxOpt                = "max"
+	rdsTLSOpt                = "tls"
+	rdsCAFileOpt             = "ca_file"
+	rdsInsecureSkipVerifyOpt = "insecure_skip_verify"
+	rdsTimeoutOpt            = "timeout"
+
+	// Key types
+	rdsKeyTypeList          = "list"
+	rdsKeyTypeStreamPending = "stream_pending"
+	rdsKeyTypeStreamLag     = "stream_lag"
+	rdsKeyTypeSortedSet     = "sorted_set"
+
+	// Defaults
+	rdsDefaultMin     = "-inf"
+	rdsDefaultMax     = "+inf"
+	rdsDefaultTimeout = 10 * time.Second
+
+	// Number of keys asked on each scan iteration
+	rdsScanCount = 1000
+
+	// the name
+	rdsRegName = "redis_queue"
+)
+
+// RedisQueue represents an object for gathering the size of queues stored on Redis
+// keys, if more than one key is set the result will be the sum of all of them
+type RedisQueue struct {
+	keys       []string // The keys of the queues
+	keyPattern string   // The pattern of the keys of the queues
+	keyType    string   // The type of the keys (list, stream_pending, stream_lag, sorted_set)
+	group      string   // Stream consumer group
+	min        string   // Sorted set min score
+	max        string   // Sorted set max score
+
+	pool *redis.Pool
+	log  *log.Log // custom logger
+}
+
+// redisQueueCreator creates the redis queue gatherer creator
+type redisQueueCreator struct{}
+
+func (r *redisQueueCreator) Create(ctx context.Context, opts map[string]interface{}) (gather.Gatherer, error) {
+	return NewRedisQueue(ctx, opts)
+}
+
+// Autoregister on gatherers creators
+func init() {
+	gather.Register(rdsRegName, &redisQueueCreator{})
+}
+
+// NewRedisQueue creates a Redis queue gatherer
+func NewRedisQueue(ctx context.Context, opts map[string]interface{}) (r *RedisQueue, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	r = &RedisQueue{
+		min: rdsDefaultMin,
+		max: rdsDefaultMax,
+	}
+
+	var ok bool
+
+	address, ok := opts[rdsAddressOpt].(string)
+	if !ok || address == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", rdsAddressOpt)
+	}
+
+	// Keys, at least one of them is required
+	if v, ok := opts[rdsKeysOpt]; ok {
+		for _, k := range v.([]interface{}) {
+			r.keys = append(r.keys, k.(string))
+		}
+	}
+	if v, ok := opts[rdsKeyPatternOpt]; ok {
+		r.keyPattern = v.(string)
+	}
+	if len(r.keys) == 0 && r.keyPattern == "" {
+		return nil, fmt.Errorf("%s or %s configuration opt is required", rdsKeysOpt, rdsKeyPatternOpt)
+	}
+
+	if r.keyType, ok = opts[rdsKeyTypeOpt].(string); !ok {
+		return nil, fmt.Errorf("%s configuration opt is required", rdsKeyTypeOpt)
+	}
+	switch r.keyType {
+	case rdsKeyTypeList:
+	case rdsKeyTypeStreamPending, rdsKeyTypeStreamLag:
+		if r.group, ok = opts[rdsGroupOpt].(string); !ok || r.group == "" {
+			return nil, fmt.Errorf("%s configuration opt is required on %s key type", rdsGroupOpt, r.keyType)
+		}
+	case rdsKeyTypeSortedSet:
+		if v, ok := opts[rdsMinOpt]; ok {
+			r.min = fmt.Sprintf("%v", v)
+		}
+		if v, ok := opts[rdsMaxOpt]; ok {
+			r.max = fmt.Sprintf("%v", v)
+		}
+	default:
+		return nil, fmt.Errorf("%s configuration opt is wrong", rdsKeyTypeOpt)
+	}
+
+	// Connection options
+	timeout := rdsDefaultTimeout
+	if v, ok := opts[rdsTimeoutOpt]; ok {
+		if timeout, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", rdsTimeoutOpt, err)
+		}
+	}
+	dialOpts := []redis.DialOption{
+		redis.DialConnectTimeout(timeout),
+		redis.DialReadTimeout(timeout),
+		redis.DialWriteTimeout(timeout),
+	}
+
+	if v, ok := opts[rdsPasswordOpt]; ok {
+		dialOpts = append(dialOpts, redis.DialPassword(v.(string)))
+	}
+	if v, ok := opts[rdsDBOpt]; ok {
+		dialOpts = append(dialOpts, redis.DialDatabase(int(types.I2Int64(v))))
+	}
+
+	if v, ok := opts[rdsTLSOpt]; ok && v.(bool) {
+		var caFile string
+		var insecure bool
+		if v, ok := opts[rdsCAFileOpt]; ok {
+			caFile = v.(string)
+		}
+		if v, ok := opts[rdsInsecureSkipVerifyOpt]; ok {
+			insecure = v.(bool)
+		}
+		tlsCfg, err := utilhttp.TLSConfig(caFile, insecure)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration opt is wrong: %s", rdsCAFileOpt, err)
+		}
+		dialOpts = append(dialOpts, redis.DialUseTLS(true), redis.DialTLSConfig(tlsCfg))
+	}
+
+	// The connections are made lazily when gathering
+	r.pool = &redis.Pool{
+		MaxIdle:     1,
+		IdleTimeout: 5 * time.Minute,
+		DialContext: func(ctx context.Context) (redis.Conn, error) {
+			return redis.DialContext(ctx, "tcp", address, dialOpts...)
+		},
+	}
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	r.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "gatherer",
+		"name":       rdsRegName,
+	})
+
+	return
+}
+
+// getKeys returns the keys set on the configuration plus the keys that match the key pattern
+func (r *RedisQueue) getKeys(ctx context.Context, conn redis.Conn) ([]string, error) {
+	keys := map[string]struct{}{}
+	for _, k := range r.keys {
+		keys[k] = struct{}{}
+	}
+
+	if r.keyPattern != "" {
+		cursor := int64(0)
+		for {
+			res, err := redis.Values(redis.DoContext(conn, ctx, "SCAN", cursor, "MATCH", r.keyPattern, "COUNT", rdsScanCount))
+			if err != nil {
+				return nil, err
+			}
+			if len(res) != 2 {
+				return nil, fmt.Errorf("wrong scan response")
+			}
+			if cursor, err = redis.Int64(res[0], nil); err != nil {
+				return nil, err
+			}
+			ks, err := redis.Strings(res[1], nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range ks {
+				keys[k] = struct{}{}
+			}
+
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+
+	res := make([]string, 0, len(keys))
+	for k := range keys {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// streamLag returns the lag of the consumer group on a stream, this is the number of
+// entries not delivered yet to the group consumers (requires Redis 7)
+func (r *RedisQueue) streamLag(ctx context.Context, conn redis.Conn, key string) (int64, error) {
+	groups, err := redis.Values(redis.DoContext(conn, ctx, "XINFO", "GROUPS", key))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, g := range groups {
+		info, err := redis.Values(g, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		var name string
+		var lag interface{}
+		for i := 0; i+1 < len(info); i += 2 {
+			field, err := redis.String(info[i], nil)
+			if err != nil {
+				return 0, err
+			}
+			switch field {
+			case "name":
+				if name, err = redis.String(info[i+1], nil); err != nil {
+					return 0, err
+				}
+			case "lag":
+				lag = info[i+1]
+			}
+		}
+
+		if name != r.group {
+			continue
+		}
+		if lag == nil {
+			return 0, fmt.Errorf("lag of '%s' group on '%s' stream is not available", r.group, key)
+		}
+		return redis.Int64(lag, nil)
+	}
+
+	return 0, fmt.Errorf("'%s' group missing on '%s' stream", r.group, key)
+}
+
+// keySize returns the size of the queue of a key
+func (r *RedisQueue) keySize(ctx context.Context, conn redis.Conn, key string) (int64, error) {
+	switch r.keyType {
+	case rdsKeyTypeList:
+		return redis.Int64(redis.DoContext(conn, ctx, "LLEN", key))
+	case rdsKeyTypeSortedSet:
+		return redis.Int64(redis.DoContext(conn, ctx, "ZCOUNT", key, r.min, r.max))
+	case rdsKeyTypeStreamPending:
+		// The summary form returns the number of pending entries as the first element
+		res, err := redis.Values(redis.DoContext(conn, ctx, "XPENDING", key, r.group))
+		if err != nil {
+			return 0, err
+		}
+		if len(res) == 0 {
+			return 0, fmt.Errorf("wrong xpending response")
+		}
+		return redis.Int64(res[0], nil)
+	case rdsKeyTypeStreamLag:
+		return r.streamLag(ctx, conn, key)
+	}
+	return 0, fmt.Errorf("invalid key type: %s", r.keyType)
+}
+
+// Gather returns the sum of the queue sizes of all the keys
+func (r *RedisQueue) Gather(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{Q: 0}
+
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return q, err
+	}
+	defer conn.Close()
+
+	keys, err := r.getKeys(ctx, conn)
+	if err != nil {
+		return q, err
+	}
+
+	for _, k := range keys {
+		r.log.Debugf("Gathering '%s' redis key %s", k, r.keyType)
+		s, err := r.keySize(ctx, conn, k)
+		if err != nil {
+			return types.Quantity{}, fmt.Errorf("error gathering '%s' key: %s", k, err)
+		}
+		q.Q += s
+	}
+
+	r.log.Debugf("Retrieved redis queue input: %s", q)
+
+	return q, nil
+}
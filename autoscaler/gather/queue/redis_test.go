@@ -0,0 +1,263 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+)
+
+func TestRedisQueueCreation(t *testing.T) {
+	tests := []struct {
+		opts map[string]interface{}
+
+		wantMin   string
+		wantMax   string
+		wantError bool
+	}{
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"resque:queue:renders"},
+				rdsKeyTypeOpt: rdsKeyTypeList,
+			},
+			wantMin: rdsDefaultMin,
+			wantMax: rdsDefaultMax,
+		},
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt:    "127.0.0.1:6379",
+				rdsKeyPatternOpt: "schedule:*",
+				rdsKeyTypeOpt:    rdsKeyTypeSortedSet,
+				rdsMinOpt:        0,
+				rdsMaxOpt:        "(100",
+				rdsPasswordOpt:   "secret",
+				rdsDBOpt:         2,
+				rdsTimeoutOpt:    "2s",
+				rdsTLSOpt:        true,
+			},
+			wantMin: "0",
+			wantMax: "(100",
+		},
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"jobs"},
+				rdsKeyTypeOpt: rdsKeyTypeStreamLag,
+				rdsGroupOpt:   "workers",
+			},
+			wantMin: rdsDefaultMin,
+			wantMax: rdsDefaultMax,
+		},
+		// Missing address
+		{
+			opts: map[string]interface{}{
+				rdsKeysOpt:    []interface{}{"jobs"},
+				rdsKeyTypeOpt: rdsKeyTypeList,
+			},
+			wantError: true,
+		},
+		// Missing keys
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeyTypeOpt: rdsKeyTypeList,
+			},
+			wantError: true,
+		},
+		// Missing key type
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"jobs"},
+			},
+			wantError: true,
+		},
+		// Wrong key type
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"jobs"},
+				rdsKeyTypeOpt: "hash",
+			},
+			wantError: true,
+		},
+		// Missing stream group
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"jobs"},
+				rdsKeyTypeOpt: rdsKeyTypeStreamPending,
+			},
+			wantError: true,
+		},
+		// Wrong timeout
+		{
+			opts: map[string]interface{}{
+				rdsAddressOpt: "127.0.0.1:6379",
+				rdsKeysOpt:    []interface{}{"jobs"},
+				rdsKeyTypeOpt: rdsKeyTypeList,
+				rdsTimeoutOpt: "10",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		r, err := NewRedisQueue(context.TODO(), test.opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if r.min != test.wantMin || r.max != test.wantMax {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object, want: %v, %v; got %v, %v", test, test.wantMin, test.wantMax, r.min, r.max)
+		}
+	}
+}
+
+func TestRedisQueueGather(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Error starting redis: %v", err)
+	}
+	defer s.Close()
+
+	// Lists
+	s.Push("resque:queue:renders", "j1", "j2", "j3")
+	s.Push("resque:queue:uploads", "j1", "j2")
+	s.Push("celery", "j1")
+
+	// Sorted sets
+	s.ZAdd("schedule:renders", 10, "j1")
+	s.ZAdd("schedule:renders", 20, "j2")
+	s.ZAdd("schedule:renders", 30, "j3")
+	s.ZAdd("schedule:uploads", 15, "j1")
+
+	// Streams
+	for _, k := range []string{"stream:renders", "stream:uploads"} {
+		for i := 0; i < 5; i++ {
+			if _, err := s.XAdd(k, "*", []string{"job", "render"}); err != nil {
+				t.Fatalf("Error preparing streams: %v", err)
+			}
+		}
+	}
+	conn, err := redis.Dial("tcp", s.Addr())
+	if err != nil {
+		t.Fatalf("Error connecting to redis: %v", err)
+	}
+	defer conn.Close()
+	for _, k := range []string{"stream:renders", "stream:uploads"} {
+		if _, err := conn.Do("XGROUP", "CREATE", k, "workers", "0"); err != nil {
+			t.Fatalf("Error preparing streams: %v", err)
+		}
+	}
+	// Read 2 entries that will be pending of acknowledge
+	if _, err := conn.Do("XREADGROUP", "GROUP", "workers", "w1", "COUNT", 2, "STREAMS", "stream:renders", ">"); err != nil {
+		t.Fatalf("Error preparing streams: %v", err)
+	}
+
+	tests := []struct {
+		keys    []interface{}
+		pattern string
+		keyType string
+		min     interface{}
+		max     interface{}
+
+		wantQ     int64
+		wantError bool
+	}{
+		{[]interface{}{"resque:queue:renders"}, "", rdsKeyTypeList, nil, nil, 3, false},
+		{[]interface{}{"resque:queue:renders", "celery"}, "", rdsKeyTypeList, nil, nil, 4, false},
+		{nil, "resque:queue:*", rdsKeyTypeList, nil, nil, 5, false},
+		// Keys on both are counted once
+		{[]interface{}{"resque:queue:renders", "celery"}, "resque:queue:*", rdsKeyTypeList, nil, nil, 6, false},
+		// Missing lists are empty
+		{[]interface{}{"missing"}, "", rdsKeyTypeList, nil, nil, 0, false},
+		{nil, "missing:*", rdsKeyTypeList, nil, nil, 0, false},
+		{[]interface{}{"schedule:renders"}, "", rdsKeyTypeSortedSet, nil, nil, 3, false},
+		{[]interface{}{"schedule:renders"}, "", rdsKeyTypeSortedSet, 15, "(30", 1, false},
+		{nil, "schedule:*", rdsKeyTypeSortedSet, "-inf", 20, 3, false},
+		{[]interface{}{"stream:renders"}, "", rdsKeyTypeStreamPending, nil, nil, 2, false},
+		{nil, "stream:*", rdsKeyTypeStreamPending, nil, nil, 2, false},
+		{[]interface{}{"stream:uploads"}, "", rdsKeyTypeStreamLag, nil, nil, 5, false},
+		// Wrong types are bad
+		{[]interface{}{"schedule:renders"}, "", rdsKeyTypeList, nil, nil, 0, true},
+		// Missing groups are bad
+		{[]interface{}{"resque:queue:renders"}, "", rdsKeyTypeStreamPending, nil, nil, 0, true},
+		{[]interface{}{"stream:missing"}, "", rdsKeyTypeStreamLag, nil, nil, 0, true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			rdsAddressOpt: s.Addr(),
+			rdsKeyTypeOpt: test.keyType,
+			rdsGroupOpt:   "workers",
+		}
+		if test.keys != nil {
+			opts[rdsKeysOpt] = test.keys
+		}
+		if test.pattern != "" {
+			opts[rdsKeyPatternOpt] = test.pattern
+		}
+		if test.min != nil {
+			opts[rdsMinOpt] = test.min
+		}
+		if test.max != nil {
+			opts[rdsMaxOpt] = test.max
+		}
+
+		r, err := NewRedisQueue(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+		}
+
+		q, err := r.Gather(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Gather should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if q.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v; got %v", test, test.wantQ, q.Q)
+		}
+	}
+}
+
+func TestRedisQueueGatherConnectionError(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Error starting redis: %v", err)
+	}
+	addr := s.Addr()
+	s.Close()
+
+	r, err := NewRedisQueue(context.TODO(), map[string]interface{}{
+		rdsAddressOpt: addr,
+		rdsKeysOpt:    []interface{}{"jobs"},
+		rdsKeyTypeOpt: rdsKeyTypeList,
+	})
+	if err != nil {
+		t.Fatalf("Creation shouldn't give error: %v", err)
+	}
+
+	if _, err := r.Gather(context.TODO()); err == nil {
+		t.Errorf("Gather should give error, it didn't")
+	}
+}
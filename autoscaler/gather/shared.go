@@ -0,0 +1,123 @@
+package gather
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+var (
+	// rwmutex for the shared gatherers registry
+	sharedMu sync.RWMutex
+	// shared is where we store all the registered shared gatherers in order to retrieve
+	// them from the inputters of the autoscalers
+	shared = make(map[string]Gatherer)
+)
+
+// RegisterShared registers a Gatherer that will be shared between multiple autoscalers
+func RegisterShared(name string, g Gatherer) error {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	if g == nil {
+		return fmt.Errorf("%s shared gatherer can't register as a nil", name)
+	}
+
+	if _, dup := shared[name]; dup {
+		return fmt.Errorf("%s shared gatherer already registered", name)
+	}
+
+	log.Logger.Infof("Shared gatherer registered: %s", name)
+	shared[name] = g
+	return nil
+}
+
+// UnregisterAllShared flushes the list of registered shared gatherers, used mainly
+// on tests
+func UnregisterAllShared() {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	shared = make(map[string]Gatherer)
+}
+
+// Shared returns the shared Gatherer registered with the received name
+func Shared(name string) (Gatherer, error) {
+	sharedMu.RLock()
+	defer sharedMu.RUnlock()
+
+	g, ok := shared[name]
+	if !ok {
+		return nil, fmt.Errorf("%s shared gatherer not registered", name)
+	}
+	return g, nil
+}
+
+// Cached is a Gatherer that wraps other Gatherer and caches its result (quantity or error)
+// for a TTL, this way multiple autoscalers gathering on the same iteration window will
+// gather only once from the source
+type Cached struct {
+	gatherer Gatherer
+	ttl      time.Duration
+
+	mu        sync.Mutex // Only one gathering at a time, the others wait and get the cached result
+	gathered  time.Time  // When was the cached result gathered
+	q         types.Quantity
+	err       error
+	now       func() time.Time // Clock, used to get the current time
+	hasResult bool
+}
+
+// NewCached returns a new Cached gatherer
+func NewCached(g Gatherer, ttl time.Duration) *Cached {
+	return &Cached{
+		gatherer: g,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// Gather returns the cached result if not expired, if expired then it will gather again
+func (c *Cached) Gather(ctx context.Context) (types.Quantity, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.hasResult && c.now().Sub(c.gathered) < c.ttl {
+		return c.q, c.err
+	}
+
+	q, err := c.gatherer.Gather(ctx)
+
+	// Don't cache the results of the cancelled gatherings, the cancellation is from the
+	// autoscaler that gathered, not from the source
+	if ctx.Err() != nil {
+		return q, err
+	}
+
+	c.q, c.err = q, err
+	c.gathered = c.now()
+	c.hasResult = true
+	return q, err
+}
+
+// Push pushes the quantity to the wrapped gatherer if it accepts pushed values, the cached
+// result is discarded so the next gathering returns the pushed quantity
+func (c *Cached) Push(q types.Quantity) error {
+	p, ok := c.gatherer.(Pusher)
+	if !ok {
+		return fmt.Errorf("shared gatherer doesn't accept pushed values")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := p.Push(q); err != nil {
+		return err
+	}
+	c.hasResult = false
+	return nil
+}
@@ -0,0 +1,164 @@
+package gather
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/types"
+)
+
+// countGatherer counts the gatherings and returns the count as the quantity
+type countGatherer struct {
+	count int64
+	err   error
+}
+
+func (c *countGatherer) Gather(ctx context.Context) (types.Quantity, error) {
+	n := atomic.AddInt64(&c.count, 1)
+	// Give time to the other gatherings to wait
+	time.Sleep(5 * time.Millisecond)
+	return types.Quantity{Q: n}, c.err
+}
+
+func TestSharedRegister(t *testing.T) {
+	UnregisterAllShared()
+	defer UnregisterAllShared()
+
+	g := NewCached(&countGatherer{}, time.Second)
+	if err := RegisterShared("test", g); err != nil {
+		t.Fatalf("Register shouldn't give error: %v", err)
+	}
+	if err := RegisterShared("test", g); err == nil {
+		t.Errorf("Register of duplicated shared gatherer should give error, it didn't")
+	}
+	if err := RegisterShared("test2", nil); err == nil {
+		t.Errorf("Register of nil shared gatherer should give error, it didn't")
+	}
+
+	got, err := Shared("test")
+	if err != nil {
+		t.Fatalf("Getting shared gatherer shouldn't give error: %v", err)
+	}
+	if got != g {
+		t.Errorf("Registered shared gatherer is not the expected one, got: %v, want: %v", got, g)
+	}
+	if _, err := Shared("test2"); err == nil {
+		t.Errorf("Getting missing shared gatherer should give error, it didn't")
+	}
+}
+
+func TestCachedGather(t *testing.T) {
+	tests := []struct {
+		elapsed   time.Duration
+		wantQ     int64
+		wantCount int64
+	}{
+		{0, 1, 1},
+		{5 * time.Second, 1, 1},
+		{9 * time.Second, 1, 1},
+		{10 * time.Second, 2, 2},
+		{19 * time.Second, 2, 2},
+		{21 * time.Second, 3, 3},
+	}
+
+	cg := &countGatherer{}
+	c := NewCached(cg, 10*time.Second)
+	start := time.Now()
+	now := start
+	c.now = func() time.Time { return now }
+
+	for _, test := range tests {
+		now = start.Add(test.elapsed)
+		q, err := c.Gather(context.TODO())
+		if err != nil {
+			t.Errorf("\n- %+v\n  Gather shouldn't give error: %v", test, err)
+			continue
+		}
+		if q.Q != test.wantQ || cg.count != test.wantCount {
+			t.Errorf("\n- %+v\n  Wrong gathering retrieved value, want: %v, %v; got %v, %v", test, test.wantQ, test.wantCount, q.Q, cg.count)
+		}
+	}
+}
+
+func TestCachedGatherConcurrent(t *testing.T) {
+	cg := &countGatherer{err: errors.New("wanted error")}
+	c := NewCached(cg, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// All of them should get the same error
+			q, err := c.Gather(context.TODO())
+			if err == nil || q.Q != 1 {
+				t.Errorf("Gather should give the shared error and quantity, got: %v, %v", q, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if cg.count != 1 {
+		t.Errorf("Wrong number of gatherings, want: %d; got %d", 1, cg.count)
+	}
+}
+
+func TestCachedGatherCancelled(t *testing.T) {
+	cg := &countGatherer{}
+	c := NewCached(cg, time.Minute)
+
+	// Cancelled gatherings aren't cached
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	c.Gather(ctx)
+
+	q, err := c.Gather(context.TODO())
+	if err != nil {
+		t.Fatalf("Gather shouldn't give error: %v", err)
+	}
+	if q.Q != 2 {
+		t.Errorf("Wrong gathering retrieved value, want: %v; got %v", 2, q.Q)
+	}
+}
+
+// pushGatherer returns the pushed quantity
+type pushGatherer struct {
+	q types.Quantity
+}
+
+func (p *pushGatherer) Gather(ctx context.Context) (types.Quantity, error) {
+	return p.q, nil
+}
+
+func (p *pushGatherer) Push(q types.Quantity) error {
+	p.q = q
+	return nil
+}
+
+func TestCachedPush(t *testing.T) {
+	c := NewCached(&pushGatherer{q: types.Quantity{Q: 1}}, time.Minute)
+
+	if q, _ := c.Gather(context.TODO()); q.Q != 1 {
+		t.Errorf("Wrong gathering retrieved value, want: %v; got %v", 1, q.Q)
+	}
+
+	// The pushed quantity is returned without waiting the TTL
+	if err := c.Push(types.Quantity{Q: 10}); err != nil {
+		t.Fatalf("Push shouldn't give error: %v", err)
+	}
+	if q, _ := c.Gather(context.TODO()); q.Q != 10 {
+		t.Errorf("Wrong gathering retrieved value, want: %v; got %v", 10, q.Q)
+	}
+}
+
+func TestCachedPushNotPusher(t *testing.T) {
+	c := NewCached(&countGatherer{}, time.Minute)
+
+	if err := c.Push(types.Quantity{Q: 10}); err == nil {
+		t.Errorf("Push should give error, it didn't")
+	}
+}
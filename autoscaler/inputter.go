@@ -52,6 +52,18 @@ func newInputter(ctx context.Context, cfg *config.Inputter) (*inputter, error) {
 // setGatherer sets the correct Gatherer
 func (i *inputter) setGatherer(ctx context.Context) error {
 	if i.gatherer == nil {
+		// Shared gatherers are already created, use them
+		if i.config.SharedGather != "" {
+			g, err := gather.Shared(i.config.SharedGather)
+			if err != nil {
+				i.log.Errorf("error getting shared gatherer: %v", err)
+				return err
+			}
+			i.gatherer = g
+			i.log.Debugf("Shared gatherer %s set", i.config.SharedGather)
+			return nil
+		}
+
 		if i.config.Gather.Kind == "" {
 			err := fmt.Errorf("gatherer type can't be empty")
 			i.log.Errorf("error creating gatherer: %v", err)
@@ -3,8 +3,10 @@ package autoscaler
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/themotion/ladder/autoscaler/arrange"
+	"github.com/themotion/ladder/autoscaler/gather"
 	"github.com/themotion/ladder/config"
 	"github.com/themotion/ladder/log"
 	"github.com/themotion/ladder/types"
@@ -13,6 +15,11 @@ import (
 func TestNewInputter(t *testing.T) {
 	// Register all dummies
 	registerDummies()
+	gather.UnregisterAllShared()
+	defer gather.UnregisterAllShared()
+	if err := RegisterSharedGatherer(&config.SharedGatherer{Name: "shared0", TTL: time.Second, Block: config.Block{Kind: "test0"}}); err != nil {
+		t.Fatalf("Shared gatherer creation shouldn't give an error: %v", err)
+	}
 
 	tests := []struct {
 		cfg       *config.Inputter
@@ -37,6 +44,20 @@ func TestNewInputter(t *testing.T) {
 				Arrange: config.Block{Kind: "test0"}},
 			wantError: true,
 		},
+		{
+			cfg: &config.Inputter{
+				Name:         "test0_input",
+				SharedGather: "shared0",
+				Gather:       config.Block{Kind: "test0"}},
+			wantError: false,
+		},
+		{
+			cfg: &config.Inputter{
+				Name:         "test0_input",
+				SharedGather: "shared1",
+				Gather:       config.Block{Kind: "test0"}},
+			wantError: true,
+		},
 	}
 
 	for _, test := range tests {
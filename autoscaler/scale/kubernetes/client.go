@@ -0,0 +1,377 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// In cluster service account files and env vars
+	inClusterTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	inClusterCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	inClusterHostEnv   = "KUBERNETES_SERVICE_HOST"
+	inClusterPortEnv   = "KUBERNETES_SERVICE_PORT"
+
+	// Default timeout for the api calls
+	defaultClientTimeout = 30 * time.Second
+)
+
+// workloadKinds maps the supported workload kinds to their apps/v1 API resources
+var workloadKinds = map[string]string{
+	"deployment":  "deployments",
+	"statefulset": "statefulsets",
+	"replicaset":  "replicasets",
+}
+
+// workloadClient is the client used by the workload scaler to talk with Kubernetes,
+// it only knows about the scale subresource and the status of the workloads
+type workloadClient interface {
+	// GetReplicas returns the desired replicas of the scale subresource of a workload
+	GetReplicas(ctx context.Context, kind, namespace, name string) (int64, error)
+	// SetReplicas sets the desired replicas on the scale subresource of a workload
+	SetReplicas(ctx context.Context, kind, namespace, name string, replicas int64) error
+	// GetReadyReplicas returns the ready replicas of a workload
+	GetReadyReplicas(ctx context.Context, kind, namespace, name string) (int64, error)
+}
+
+// scaleObject is the autoscaling/v1 Scale object of a workload
+type scaleObject struct {
+	Spec struct {
+		Replicas int64 `json:"replicas"`
+	} `json:"spec"`
+	Status struct {
+		Replicas int64 `json:"replicas"`
+	} `json:"status"`
+}
+
+// workloadObject is the part of a workload object we are interested in
+type workloadObject struct {
+	Status struct {
+		ReadyReplicas int64 `json:"readyReplicas"`
+	} `json:"status"`
+}
+
+// restClient is a minimal Kubernetes API client that satisfies workloadClient
+type restClient struct {
+	host   string
+	token  string
+	user   string
+	pass   string
+	client *http.Client
+}
+
+// restConfig has the data required to connect to the Kubernetes API
+type restConfig struct {
+	host      string
+	token     string
+	user      string
+	pass      string
+	tlsConfig *tls.Config
+}
+
+// newRESTClient creates a new rest client based on the configuration
+func newRESTClient(cfg *restConfig) *restClient {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:     cfg.tlsConfig,
+	}
+
+	return &restClient{
+		host:  strings.TrimRight(cfg.host, "/"),
+		token: cfg.token,
+		user:  cfg.user,
+		pass:  cfg.pass,
+		client: &http.Client{
+			Transport: tr,
+			Timeout:   defaultClientTimeout,
+		},
+	}
+}
+
+// resourcePath returns the API path of a workload
+func resourcePath(kind, namespace, name string) (string, error) {
+	res, ok := workloadKinds[kind]
+	if !ok {
+		return "", fmt.Errorf("%s is not a valid workload kind", kind)
+	}
+	return fmt.Sprintf("/apis/apps/v1/namespaces/%s/%s/%s", namespace, res, name), nil
+}
+
+// do makes a request to the Kubernetes API and decodes the response on result if not nil
+func (r *restClient) do(ctx context.Context, method, path, contentType string, body []byte, result interface{}) error {
+	req, err := http.NewRequest(method, r.host+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	switch {
+	case r.token != "":
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	case r.user != "":
+		req.SetBasicAuth(r.user, r.pass)
+	}
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("kubernetes API returned %d status code: %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(b, result)
+}
+
+// GetReplicas satisfies workloadClient interface
+func (r *restClient) GetReplicas(ctx context.Context, kind, namespace, name string) (int64, error) {
+	p, err := resourcePath(kind, namespace, name)
+	if err != nil {
+		return 0, err
+	}
+
+	s := &scaleObject{}
+	if err := r.do(ctx, http.MethodGet, p+"/scale", "", nil, s); err != nil {
+		return 0, err
+	}
+	return s.Spec.Replicas, nil
+}
+
+// SetReplicas satisfies workloadClient interface
+func (r *restClient) SetReplicas(ctx context.Context, kind, namespace, name string, replicas int64) error {
+	p, err := resourcePath(kind, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	body := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+	return r.do(ctx, http.MethodPatch, p+"/scale", "application/merge-patch+json", body, nil)
+}
+
+// GetReadyReplicas satisfies workloadClient interface
+func (r *restClient) GetReadyReplicas(ctx context.Context, kind, namespace, name string) (int64, error) {
+	p, err := resourcePath(kind, namespace, name)
+	if err != nil {
+		return 0, err
+	}
+
+	w := &workloadObject{}
+	if err := r.do(ctx, http.MethodGet, p, "", nil, w); err != nil {
+		return 0, err
+	}
+	return w.Status.ReadyReplicas, nil
+}
+
+// inClusterConfig loads the configuration from the service account that
+// Kubernetes mounts on every pod
+func inClusterConfig() (*restConfig, error) {
+	host, port := os.Getenv(inClusterHostEnv), os.Getenv(inClusterPortEnv)
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("not running inside a kubernetes cluster, %s and %s are not set", inClusterHostEnv, inClusterPortEnv)
+	}
+
+	token, err := ioutil.ReadFile(inClusterTokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := ioutil.ReadFile(inClusterCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("could not load cluster CA from %s", inClusterCAFile)
+	}
+
+	return &restConfig{
+		host:      "https://" + net.JoinHostPort(host, port),
+		token:     strings.TrimSpace(string(token)),
+		tlsConfig: &tls.Config{RootCAs: pool},
+	}, nil
+}
+
+// kubeconfig is the subset of the kubeconfig file format that we support, the users
+// authenticated with exec or auth-provider plugins are not supported
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string      `yaml:"token"`
+			Username              string      `yaml:"username"`
+			Password              string      `yaml:"password"`
+			ClientCertificate     string      `yaml:"client-certificate"`
+			ClientCertificateData string      `yaml:"client-certificate-data"`
+			ClientKey             string      `yaml:"client-key"`
+			ClientKeyData         string      `yaml:"client-key-data"`
+			Exec                  interface{} `yaml:"exec"`
+			AuthProvider          interface{} `yaml:"auth-provider"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// fileOrData returns the content of data (base64 encoded) or if empty the content of the file,
+// relative files are relative to dir (like kubectl does with the kubeconfig file directory)
+func fileOrData(dir, file, data string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if file == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+	return ioutil.ReadFile(file)
+}
+
+// kubeconfigConfig loads the configuration from a kubeconfig file, if context
+// is empty the current context of the file will be used
+func kubeconfigConfig(path, context string) (*restConfig, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kc := &kubeconfig{}
+	if err := yaml.Unmarshal(b, kc); err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+
+	if context == "" {
+		context = kc.CurrentContext
+	}
+
+	// Get the cluster and user names of the context
+	var clusterName, userName string
+	found := false
+	for _, c := range kc.Contexts {
+		if c.Name == context {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("context '%s' not present on kubeconfig", context)
+	}
+
+	cfg := &restConfig{tlsConfig: &tls.Config{}}
+
+	// Load the cluster
+	found = false
+	for _, c := range kc.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		found = true
+		cfg.host = c.Cluster.Server
+		cfg.tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+
+		ca, err := fileOrData(dir, c.Cluster.CertificateAuthority, c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, err
+		}
+		if len(ca) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("could not load the CA of '%s' cluster", clusterName)
+			}
+			cfg.tlsConfig.RootCAs = pool
+		}
+		break
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster '%s' not present on kubeconfig", clusterName)
+	}
+
+	if cfg.host == "" {
+		return nil, fmt.Errorf("cluster '%s' doesn't have a server", clusterName)
+	}
+
+	// Load the user, a context without user is valid
+	for _, u := range kc.Users {
+		if u.Name != userName {
+			continue
+		}
+		switch {
+		case u.User.Exec != nil:
+			return nil, fmt.Errorf("user '%s' uses exec authentication, it's not supported", userName)
+		case u.User.AuthProvider != nil:
+			return nil, fmt.Errorf("user '%s' uses auth-provider authentication, it's not supported", userName)
+		}
+
+		cfg.token = u.User.Token
+		cfg.user = u.User.Username
+		cfg.pass = u.User.Password
+
+		cert, err := fileOrData(dir, u.User.ClientCertificate, u.User.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		key, err := fileOrData(dir, u.User.ClientKey, u.User.ClientKeyData)
+		if err != nil {
+			return nil, err
+		}
+		if len(cert) > 0 && len(key) > 0 {
+			c, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, err
+			}
+			cfg.tlsConfig.Certificates = []tls.Certificate{c}
+		}
+		break
+	}
+
+	return cfg, nil
+}
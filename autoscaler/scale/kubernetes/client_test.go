@@ -0,0 +1,254 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRESTClient(t *testing.T) {
+	tests := []struct {
+		kind string
+
+		wantPath     string
+		wantReplicas int64
+		wantReady    int64
+		wantError    bool
+	}{
+		{"deployment", "/apis/apps/v1/namespaces/default/deployments/test", 3, 2, false},
+		{"statefulset", "/apis/apps/v1/namespaces/default/statefulsets/test", 3, 2, false},
+		{"replicaset", "/apis/apps/v1/namespaces/default/replicasets/test", 3, 2, false},
+		{"daemonset", "", 0, 0, true},
+	}
+
+	for _, test := range tests {
+		var gotPatch string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer test-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == test.wantPath+"/scale":
+				w.Write([]byte(`{"kind":"Scale","spec":{"replicas":3},"status":{"replicas":2}}`))
+			case r.Method == http.MethodPatch && r.URL.Path == test.wantPath+"/scale":
+				if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+					w.WriteHeader(http.StatusUnsupportedMediaType)
+					return
+				}
+				b, _ := ioutil.ReadAll(r.Body)
+				gotPatch = string(b)
+				w.Write([]byte(`{}`))
+			case r.Method == http.MethodGet && r.URL.Path == test.wantPath:
+				w.Write([]byte(`{"status":{"replicas":3,"readyReplicas":2}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		c := newRESTClient(&restConfig{host: ts.URL, token: "test-token"})
+
+		r, err := c.GetReplicas(context.TODO(), test.kind, "default", "test")
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  get replicas should give error, it didn't", test)
+			}
+			ts.Close()
+			continue
+		}
+		if err != nil {
+			t.Errorf("\n- %+v\n  get replicas shouldn't give error: %v", test, err)
+		}
+		if r != test.wantReplicas {
+			t.Errorf("\n- %+v\n  wrong replicas; got: %d, want: %d", test, r, test.wantReplicas)
+		}
+
+		if err := c.SetReplicas(context.TODO(), test.kind, "default", "test", 7); err != nil {
+			t.Errorf("\n- %+v\n  set replicas shouldn't give error: %v", test, err)
+		}
+		if gotPatch != `{"spec":{"replicas":7}}` {
+			t.Errorf("\n- %+v\n  wrong patch sent: %s", test, gotPatch)
+		}
+
+		rr, err := c.GetReadyReplicas(context.TODO(), test.kind, "default", "test")
+		if err != nil {
+			t.Errorf("\n- %+v\n  get ready replicas shouldn't give error: %v", test, err)
+		}
+		if rr != test.wantReady {
+			t.Errorf("\n- %+v\n  wrong ready replicas; got: %d, want: %d", test, rr, test.wantReady)
+		}
+
+		// Wrong auth should error
+		c.token = "wrong"
+		if _, err := c.GetReplicas(context.TODO(), test.kind, "default", "test"); err == nil {
+			t.Errorf("\n- %+v\n  get replicas with wrong auth should give error, it didn't", test)
+		}
+		ts.Close()
+	}
+}
+
+func TestKubeconfigConfig(t *testing.T) {
+	f, err := ioutil.TempFile("", "kubeconfig")
+	if err != nil {
+		t.Fatalf("Error creating kubeconfig file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(testKubeconfig)
+	f.Close()
+
+	cfg, err := kubeconfigConfig(f.Name(), "")
+	if err != nil {
+		t.Fatalf("Loading kubeconfig shouldn't give error: %v", err)
+	}
+
+	if cfg.host != "https://127.0.0.1:6443" {
+		t.Errorf("Wrong host loaded; got: %s, want: %s", cfg.host, "https://127.0.0.1:6443")
+	}
+
+	if cfg.token != "test-token" {
+		t.Errorf("Wrong token loaded; got: %s, want: %s", cfg.token, "test-token")
+	}
+
+	if !cfg.tlsConfig.InsecureSkipVerify {
+		t.Errorf("Insecure skip TLS verify should be loaded")
+	}
+}
+
+// testCertificate generates a self signed certificate and its key in PEM format
+func testCertificate(t *testing.T) (cert, key []byte) {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ladder"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &k.PublicKey, k)
+	if err != nil {
+		t.Fatalf("Error generating certificate: %v", err)
+	}
+	kb, err := x509.MarshalECPrivateKey(k)
+	if err != nil {
+		t.Fatalf("Error marshaling key: %v", err)
+	}
+
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	key = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb})
+	return
+}
+
+func TestKubeconfigConfigRelativePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfig")
+	if err != nil {
+		t.Fatalf("Error creating kubeconfig dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cert, key := testCertificate(t)
+	os.Mkdir(filepath.Join(dir, "certs"), 0700)
+	files := map[string][]byte{
+		"certs/ca.crt":     cert,
+		"certs/client.crt": cert,
+		"certs/client.key": key,
+		"config": []byte(`
+apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test-cluster
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority: certs/ca.crt
+users:
+- name: test-user
+  user:
+    client-certificate: certs/client.crt
+    client-key: certs/client.key
+contexts:
+- name: test
+  context:
+    cluster: test-cluster
+    user: test-user
+`),
+	}
+	for name, b := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0600); err != nil {
+			t.Fatalf("Error creating %s file: %v", name, err)
+		}
+	}
+
+	// Load from other working directory
+	cfg, err := kubeconfigConfig(filepath.Join(dir, "config"), "")
+	if err != nil {
+		t.Fatalf("Loading kubeconfig shouldn't give error: %v", err)
+	}
+
+	if cfg.tlsConfig.RootCAs == nil {
+		t.Errorf("Cluster CA should be loaded")
+	}
+	if len(cfg.tlsConfig.Certificates) != 1 {
+		t.Errorf("Client certificate should be loaded")
+	}
+}
+
+func TestKubeconfigConfigUnsupportedAuth(t *testing.T) {
+	tests := []struct {
+		auth string
+	}{
+		{`
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: ["eks", "get-token", "--cluster-name", "test"]`},
+		{`
+    auth-provider:
+      name: gcp`},
+	}
+
+	for _, test := range tests {
+		f, err := ioutil.TempFile("", "kubeconfig")
+		if err != nil {
+			t.Fatalf("Error creating kubeconfig file: %v", err)
+		}
+		defer os.Remove(f.Name())
+		f.WriteString(`
+apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test-cluster
+  cluster:
+    server: https://127.0.0.1:6443
+users:
+- name: test-user
+  user:` + test.auth + `
+contexts:
+- name: test
+  context:
+    cluster: test-cluster
+    user: test-user
+`)
+		f.Close()
+
+		if _, err := kubeconfigConfig(f.Name(), ""); err == nil {
+			t.Errorf("\n- %+v\n  Loading kubeconfig should give error, it didn't", test)
+		}
+	}
+}
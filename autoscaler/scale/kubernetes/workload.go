@@ -0,0 +1,188 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/themotion/ladder/autoscaler/scale"
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const (
+	// Opts
+	wlKindOpt       = "kind"
+	wlNamespaceOpt  = "namespace"
+	wlNameOpt       = "name"
+	wlInClusterOpt  = "in_cluster"
+	wlKubeconfigOpt = "kubeconfig"
+	wlContextOpt    = "context"
+
+	// the name
+	wlRegName = "kubernetes_workload"
+
+	// internal constants
+	wlDefaultWaiterInterval = 5 * time.Second
+)
+
+// Workload represents an object for scaling the replicas of a Kubernetes workload
+// (Deployment, StatefulSet or ReplicaSet) using its scale subresource
+type Workload struct {
+	client workloadClient
+
+	kind           string        // Workload kind (deployment, statefulset or replicaset)
+	namespace      string        // Workload namespace
+	name           string        // Workload name
+	waiterInterval time.Duration // Waiter check interval
+	log            *log.Log      // custom logger
+}
+
+// workloadCreator creates the kubernetes workload scaler creator
+type workloadCreator struct{}
+
+func (w *workloadCreator) Create(ctx context.Context, opts map[string]interface{}) (scale.Scaler, error) {
+	return NewWorkload(ctx, opts)
+}
+
+// Autoregister on scaler creators
+func init() {
+	scale.Register(wlRegName, &workloadCreator{})
+}
+
+// NewWorkload creates a Kubernetes workload scaler
+func NewWorkload(ctx context.Context, opts map[string]interface{}) (w *Workload, err error) {
+	// Recover from wrong type assertions
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	w = &Workload{
+		waiterInterval: wlDefaultWaiterInterval,
+	}
+
+	// Prepare ops
+	var ok bool
+
+	// Set each option with the correct type
+	if w.kind, ok = opts[wlKindOpt].(string); !ok || w.kind == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", wlKindOpt)
+	}
+	w.kind = strings.ToLower(w.kind)
+
+	if _, ok := workloadKinds[w.kind]; !ok {
+		return nil, fmt.Errorf("%s configuration opt is wrong, %s is not a valid workload kind", wlKindOpt, w.kind)
+	}
+
+	if w.namespace, ok = opts[wlNamespaceOpt].(string); !ok || w.namespace == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", wlNamespaceOpt)
+	}
+
+	if w.name, ok = opts[wlNameOpt].(string); !ok || w.name == "" {
+		return nil, fmt.Errorf("%s configuration opt is required", wlNameOpt)
+	}
+
+	// Load the Kubernetes API configuration
+	inCluster, _ := opts[wlInClusterOpt].(bool)
+	kubeconfigPath, _ := opts[wlKubeconfigOpt].(string)
+	kubeContext, _ := opts[wlContextOpt].(string)
+
+	var cfg *restConfig
+	switch {
+	case inCluster && kubeconfigPath != "":
+		return nil, fmt.Errorf("%s and %s configuration opts can't be used at the same time", wlInClusterOpt, wlKubeconfigOpt)
+	case inCluster:
+		cfg, err = inClusterConfig()
+	case kubeconfigPath != "":
+		cfg, err = kubeconfigConfig(kubeconfigPath, kubeContext)
+	default:
+		return nil, fmt.Errorf("%s or %s configuration opt is required", wlInClusterOpt, wlKubeconfigOpt)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubernetes configuration: %s", err)
+	}
+
+	w.client = newRESTClient(cfg)
+
+	// Logger
+	asName, ok := ctx.Value("autoscaler").(string)
+	if !ok {
+		asName = "unknown"
+	}
+	w.log = log.WithFields(log.Fields{
+		"autoscaler": asName,
+		"kind":       "scaler",
+		"name":       wlRegName,
+	})
+
+	return
+}
+
+// Current returns the number of desired replicas of the workload
+func (w *Workload) Current(ctx context.Context) (types.Quantity, error) {
+	q := types.Quantity{Q: 0}
+
+	w.log.Debugf("Retrieving current replicas of %s/%s %s", w.namespace, w.name, w.kind)
+
+	r, err := w.client.GetReplicas(ctx, w.kind, w.namespace, w.name)
+	if err != nil {
+		return q, err
+	}
+	q.Q = r
+	w.log.Debugf("%s/%s %s has %d replicas", w.namespace, w.name, w.kind, q.Q)
+
+	return q, nil
+}
+
+// Scale sets the desired replicas of the workload
+func (w *Workload) Scale(ctx context.Context, newQ types.Quantity) (types.Quantity, types.ScalingMode, error) {
+	mode := types.NotScaling
+	currentQ, err := w.Current(ctx)
+	if err != nil {
+		return types.Quantity{}, mode, err
+	}
+
+	// No change
+	switch {
+	case newQ.Q > currentQ.Q:
+		mode = types.ScalingUp
+	case newQ.Q < currentQ.Q:
+		mode = types.ScalingDown
+	default:
+		return types.Quantity{}, mode, err
+	}
+
+	if err := w.client.SetReplicas(ctx, w.kind, w.namespace, w.name, newQ.Q); err != nil {
+		return types.Quantity{}, mode, err
+	}
+
+	w.log.Infof("Scaled %s/%s %s from %d to %d replicas", w.namespace, w.name, w.kind, currentQ.Q, newQ.Q)
+	return newQ, mode, nil
+}
+
+// Wait will wait until the ready replicas of the workload are the scaled ones
+func (w *Workload) Wait(ctx context.Context, scaledQ types.Quantity, mode types.ScalingMode) error {
+	t := time.NewTicker(w.waiterInterval)
+	defer t.Stop()
+
+	w.log.Debugf("Start waiting for %s ready replicas meet the scaler desired quantity...", w.kind)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			r, err := w.client.GetReadyReplicas(ctx, w.kind, w.namespace, w.name)
+			if err != nil {
+				return err
+			}
+			// If met the desired ones then exit
+			if r == scaledQ.Q {
+				return nil
+			}
+			w.log.Debugf("%s/%s %s has %d ready replicas, waiting for %d", w.namespace, w.name, w.kind, r, scaledQ.Q)
+		}
+	}
+}
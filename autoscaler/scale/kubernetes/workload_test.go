@@ -0,0 +1,282 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
+)
+
+const testKubeconfig = `
+apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test-cluster
+  cluster:
+    server: https://127.0.0.1:6443
+    insecure-skip-tls-verify: true
+users:
+- name: test-user
+  user:
+    token: test-token
+contexts:
+- name: test
+  context:
+    cluster: test-cluster
+    user: test-user
+`
+
+// fakeWorkloadClient is a fake clientset that satisfies workloadClient interface
+type fakeWorkloadClient struct {
+	replicas      int64
+	readyReplicas []int64 // each call will return the next one, last one is repeated
+	wantError     bool
+
+	setCalls int
+	mu       sync.Mutex
+}
+
+func (f *fakeWorkloadClient) GetReplicas(_ context.Context, kind, namespace, name string) (int64, error) {
+	if f.wantError {
+		return 0, errors.New("Wrong!")
+	}
+	return f.replicas, nil
+}
+
+func (f *fakeWorkloadClient) SetReplicas(_ context.Context, kind, namespace, name string, replicas int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setCalls++
+	if f.wantError {
+		return errors.New("Wrong!")
+	}
+	f.replicas = replicas
+	return nil
+}
+
+func (f *fakeWorkloadClient) GetReadyReplicas(_ context.Context, kind, namespace, name string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.wantError {
+		return 0, errors.New("Wrong!")
+	}
+	r := f.readyReplicas[0]
+	if len(f.readyReplicas) > 1 {
+		f.readyReplicas = f.readyReplicas[1:]
+	}
+	return r, nil
+}
+
+func TestWorkloadCreation(t *testing.T) {
+	f, err := ioutil.TempFile("", "kubeconfig")
+	if err != nil {
+		t.Fatalf("Error creating kubeconfig file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(testKubeconfig)
+	f.Close()
+
+	tests := []struct {
+		kind       string
+		namespace  string
+		name       string
+		inCluster  bool
+		kubeconfig string
+		context    string
+
+		wantError bool
+	}{
+		{"deployment", "default", "api", false, f.Name(), "", false},
+		{"statefulset", "kube-system", "db", false, f.Name(), "test", false},
+		{"replicaset", "default", "workers", false, f.Name(), "", false},
+		{"Deployment", "default", "api", false, f.Name(), "", false},
+		{"StatefulSet", "kube-system", "db", false, f.Name(), "", false},
+		{"daemonset", "default", "api", false, f.Name(), "", true},
+		{"", "default", "api", false, f.Name(), "", true},
+		{"deployment", "", "api", false, f.Name(), "", true},
+		{"deployment", "default", "", false, f.Name(), "", true},
+		{"deployment", "default", "api", false, "", "", true},
+		{"deployment", "default", "api", false, f.Name(), "missing", true},
+		{"deployment", "default", "api", false, "/tmp/does/not/exist", "", true},
+		{"deployment", "default", "api", true, f.Name(), "", true},
+	}
+
+	for _, test := range tests {
+		opts := map[string]interface{}{
+			wlKindOpt:       test.kind,
+			wlNamespaceOpt:  test.namespace,
+			wlNameOpt:       test.name,
+			wlInClusterOpt:  test.inCluster,
+			wlKubeconfigOpt: test.kubeconfig,
+			wlContextOpt:    test.context,
+		}
+
+		w, err := NewWorkload(context.TODO(), opts)
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  Creation should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  Creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		if w.kind != strings.ToLower(test.kind) || w.namespace != test.namespace || w.name != test.name {
+			t.Errorf("\n- %+v\n  Wrong parameters loaded on object", test)
+		}
+	}
+}
+
+func TestWorkloadCurrent(t *testing.T) {
+	tests := []struct {
+		replicas  int64
+		wantError bool
+	}{
+		{5, false},
+		{0, false},
+		{100, false},
+		{5, true},
+	}
+
+	for _, test := range tests {
+		w := &Workload{
+			client:    &fakeWorkloadClient{replicas: test.replicas, wantError: test.wantError},
+			kind:      "deployment",
+			namespace: "default",
+			name:      "test",
+			log:       log.New(),
+		}
+
+		q, err := w.Current(context.TODO())
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  current calling should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  current calling shouldn't give error: %v", test, err)
+		}
+
+		if q.Q != test.replicas {
+			t.Errorf("\n- %+v\n  current didn't return the correct replicas; got: %d, want: %d", test, q.Q, test.replicas)
+		}
+	}
+}
+
+func TestWorkloadScale(t *testing.T) {
+	tests := []struct {
+		current   int64
+		desired   int64
+		wantError bool
+
+		wantMode     types.ScalingMode
+		wantQ        int64
+		wantSetCalls int
+	}{
+		{10, 10, false, types.NotScaling, 0, 0},
+		{10, 15, false, types.ScalingUp, 15, 1},
+		{10, 5, false, types.ScalingDown, 5, 1},
+		{0, 1, true, types.NotScaling, 0, 0},
+	}
+
+	for _, test := range tests {
+		c := &fakeWorkloadClient{replicas: test.current, wantError: test.wantError}
+		w := &Workload{
+			client:    c,
+			kind:      "statefulset",
+			namespace: "default",
+			name:      "test",
+			log:       log.New(),
+		}
+
+		scaled, mode, err := w.Scale(context.TODO(), types.Quantity{Q: test.desired})
+
+		if test.wantError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  scale calling should give error, it didn't", test)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\n- %+v\n  scale calling shouldn't give error: %v", test, err)
+		}
+
+		if mode != test.wantMode {
+			t.Errorf("\n- %+v\n  scale returned mode is wrong; got: %s, want: %s", test, mode, test.wantMode)
+		}
+
+		if scaled.Q != test.wantQ {
+			t.Errorf("\n- %+v\n  scale returned scalation Q is wrong; got: %d, want: %d", test, scaled.Q, test.wantQ)
+		}
+
+		if c.setCalls != test.wantSetCalls {
+			t.Errorf("\n- %+v\n  scale replicas calls are wrong; got: %d, want: %d", test, c.setCalls, test.wantSetCalls)
+		}
+	}
+}
+
+func TestWorkloadWait(t *testing.T) {
+	tests := []struct {
+		readyReplicas []int64
+		desired       int64
+		wantTimeout   bool
+	}{
+		{[]int64{10}, 5, true},
+		{[]int64{10}, 10, false},
+		{[]int64{1, 2, 3, 5}, 5, false},
+		{[]int64{1, 2, 3, 4}, 5, true},
+	}
+
+	for _, test := range tests {
+		w := &Workload{
+			client:         &fakeWorkloadClient{readyReplicas: test.readyReplicas},
+			kind:           "deployment",
+			namespace:      "default",
+			name:           "test",
+			waiterInterval: 2 * time.Millisecond,
+			log:            log.New(),
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		res := make(chan error)
+		go func() {
+			res <- w.Wait(ctx, types.Quantity{Q: test.desired}, types.ScalingUp)
+		}()
+
+		var timeout bool
+		select {
+		case <-time.After(50 * time.Millisecond):
+			timeout = true
+			cancel()
+			<-res
+		case err := <-res:
+			if err != nil {
+				t.Errorf("\n- %+v\n  Wait returned error, it shoudln't: %s", test, err)
+			}
+		}
+		cancel()
+
+		if test.wantTimeout && !timeout {
+			t.Errorf("\n- %+v\n  Wait should timeout, it dind't", test)
+		}
+
+		if !test.wantTimeout && timeout {
+			t.Errorf("\n- %+v\n  Wait shouldn't timeout, it did", test)
+		}
+	}
+}
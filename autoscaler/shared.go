@@ -0,0 +1,24 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/themotion/ladder/autoscaler/gather"
+	"github.com/themotion/ladder/config"
+)
+
+// RegisterSharedGatherer creates the gatherer of the shared gatherer configuration and
+// registers it so the inputters of the autoscalers can use it, the result of the gatherer
+// will be cached for the configured TTL
+func RegisterSharedGatherer(cfg *config.SharedGatherer) error {
+	// The shared gatherers don't belong to an autoscaler, use their name instead
+	ctx := context.WithValue(context.Background(), AutoscalerCtxKey, cfg.Name)
+
+	g, err := gather.Create(ctx, cfg.Kind, cfg.Config)
+	if err != nil {
+		return fmt.Errorf("error creating %s shared gatherer: %s", cfg.Name, err)
+	}
+
+	return gather.RegisterShared(cfg.Name, gather.NewCached(g, cfg.TTL))
+}
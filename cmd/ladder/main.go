@@ -33,9 +33,12 @@ import (
 	_ "github.com/themotion/ladder/autoscaler/filter/common"
 	_ "github.com/themotion/ladder/autoscaler/gather/aws"
 	_ "github.com/themotion/ladder/autoscaler/gather/common"
+	_ "github.com/themotion/ladder/autoscaler/gather/database"
 	_ "github.com/themotion/ladder/autoscaler/gather/metrics"
+	_ "github.com/themotion/ladder/autoscaler/gather/queue"
 	_ "github.com/themotion/ladder/autoscaler/scale/aws"
 	_ "github.com/themotion/ladder/autoscaler/scale/common"
+	_ "github.com/themotion/ladder/autoscaler/scale/kubernetes"
 	_ "github.com/themotion/ladder/autoscaler/solve/common"
 )
 
@@ -124,6 +127,16 @@ func Main() int {
 	// Create an error channel
 	errChan := make(chan error)
 
+	// Create the shared gatherers before the autoscalers so they can use them
+	for _, sg := range c.SharedGatherers {
+		sgt := sg
+		if err := autoscaler.RegisterSharedGatherer(&sgt); err != nil {
+			log.Logger.Errorf("Error creating shared gatherer %s: %v", sg.Name, err)
+			return 1
+		}
+	}
+	log.Logger.Debugf("%d shared gatherers created", len(c.SharedGatherers))
+
 	// Create all the scalers
 	autoscalers := map[string]autoscaler.Autoscaler{}
 	for _, ac := range c.Autoscalers {
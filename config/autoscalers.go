@@ -56,6 +56,10 @@ type Inputter struct {
 	Description string `yaml:"description,omitempty"`
 	Gather      Block  `yaml:"gather"`
 	Arrange     Block  `yaml:"arrange"`
+
+	// SharedGather is the name of the shared gatherer used instead of gather, when loading
+	// the configuration the gather kind will be set with the kind of the shared gatherer
+	SharedGather string `yaml:"shared_gather,omitempty"`
 }
 
 // Block will represent a block configuration, could be a solver, a gatherer, an arranger or an scaler
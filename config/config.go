@@ -37,9 +37,10 @@ func LoadConfig(filename string) (*Config, error) {
 	// never called. We thus have to set the DefaultConfig at the entry
 	// point as well.
 	cfg := &Config{
-		Global:      Global{},
-		Autoscalers: []Autoscaler{},
-		Originals:   map[string]string{},
+		Global:          Global{},
+		Autoscalers:     []Autoscaler{},
+		SharedGatherers: []SharedGatherer{},
+		Originals:       map[string]string{},
 	}
 
 	// Read the global configuration
@@ -68,6 +69,15 @@ func LoadConfig(filename string) (*Config, error) {
 	cfg.Global = *g
 	cfg.Originals[filename] = string(content)
 
+	// Shared gatherers by default are cached half of the global interval, this way every
+	// autoscaler iteration window gets a result gathered on the same window
+	for _, sg := range mainCfgTemp.SharedGatherers {
+		if sg.TTL == 0 {
+			sg.TTL = cfg.Global.Interval / 2
+		}
+		cfg.SharedGatherers = append(cfg.SharedGatherers, sg)
+	}
+
 	// We have the global configuration, we need to load all the Autoscalers
 	// Get autoscalers cfg files
 	aSFiles := []string{}
@@ -114,6 +124,11 @@ func LoadConfig(filename string) (*Config, error) {
 	cfg.Autoscalers = as
 	log.Logger.Infof("Loaded %d autoscalers", len(cfg.Autoscalers))
 
+	// Set the inputters shared gatherers
+	if err = cfg.resolveSharedGatherers(); err != nil {
+		return nil, err
+	}
+
 	// Check All the config as a whole
 	if err = cfg.Check(); err != nil {
 		return nil, err
@@ -123,8 +138,9 @@ func LoadConfig(filename string) (*Config, error) {
 
 // Config is the root level configuration
 type Config struct {
-	Global      Global
-	Autoscalers []Autoscaler
+	Global          Global
+	Autoscalers     []Autoscaler
+	SharedGatherers []SharedGatherer
 
 	// DryRun will set if needs to be run the logic or no
 	DryRun bool
@@ -153,3 +169,30 @@ func (c *Config) Check() error {
 
 	return nil
 }
+
+// resolveSharedGatherers checks the inputters that use shared gatherers and sets the
+// gather kind of the shared gatherer on them
+func (c *Config) resolveSharedGatherers() error {
+	sgs := map[string]SharedGatherer{}
+	for _, sg := range c.SharedGatherers {
+		sgs[sg.Name] = sg
+	}
+
+	for _, a := range c.Autoscalers {
+		for i, in := range a.Inputters {
+			if in.SharedGather == "" {
+				continue
+			}
+			if in.Gather.Kind != "" {
+				return fmt.Errorf("Inputter '%s' of '%s' autoscaler can't have gather and shared gather at the same time", in.Name, a.Name)
+			}
+			sg, ok := sgs[in.SharedGather]
+			if !ok {
+				return fmt.Errorf("Inputter '%s' of '%s' autoscaler shared gather '%s' is not declared", in.Name, a.Name, in.SharedGather)
+			}
+			a.Inputters[i].Gather = Block{Kind: sg.Kind}
+		}
+	}
+
+	return nil
+}
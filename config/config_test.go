@@ -157,6 +157,69 @@ func TestConfigCorrect(t *testing.T) {
 
 }
 
+func TestConfigSharedGatherers(t *testing.T) {
+	testConfFile := "testdata/shared.conf.yml"
+
+	expectedSGs := []SharedGatherer{
+		SharedGatherer{
+			Name: "render_jobs_queue",
+			TTL:  15 * time.Second,
+			Block: Block{
+				Kind: "aws_sqs",
+				Config: map[string]interface{}{
+					"queue_url":      "https://sqs.us-west-2.amazonaws.com/016386521566/slok-render-jobs",
+					"queue_property": "ApproximateNumberOfMessages",
+					"aws_region":     "us-west-2",
+				},
+			},
+		},
+		SharedGatherer{
+			Name: "render_workers",
+			TTL:  5 * time.Second,
+			Block: Block{
+				Kind: "dummy",
+				Config: map[string]interface{}{
+					"quantity": 10,
+				},
+			},
+		},
+	}
+	expectedGathers := map[string][]Block{
+		"render_amis_autoscaler":     []Block{Block{Kind: "aws_sqs"}},
+		"render_gpu_amis_autoscaler": []Block{Block{Kind: "aws_sqs"}, Block{Kind: "dummy"}},
+	}
+
+	c, err := LoadConfig(testConfFile)
+	if err != nil {
+		t.Fatalf("Error loading %s configuration: %v", testConfFile, err)
+	}
+
+	bgot, err := yaml.Marshal(c.SharedGatherers)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	bwant, err := yaml.Marshal(expectedSGs)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	if !reflect.DeepEqual(bgot, bwant) {
+		t.Fatalf("%s: unexpected shared gatherers result: \n\n%s\n expected\n\n%s", testConfFile, bgot, bwant)
+	}
+
+	// The inputters should have the kind of the shared gatherer
+	for _, a := range c.Autoscalers {
+		got := []Block{}
+		for _, in := range a.Inputters {
+			got = append(got, in.Gather)
+		}
+		if !reflect.DeepEqual(got, expectedGathers[a.Name]) {
+			t.Errorf("%s: unexpected inputters gather, want: %v; got %v", a.Name, expectedGathers[a.Name], got)
+		}
+	}
+}
+
 func TestConfigWithErrors(t *testing.T) {
 	tests := []string{
 		"testdata/bad.conf.1.yml", // No autoscalers_files
@@ -165,6 +228,9 @@ func TestConfigWithErrors(t *testing.T) {
 		"testdata/bad.conf.4.yml", // No scaler
 		"testdata/bad.conf.5.yml", // No name on autoscaler
 		"testdata/bad.conf.6.yml", // Multiple autoscalers, same names
+		"testdata/bad.conf.7.yml", // Missing shared gatherer
+		"testdata/bad.conf.8.yml", // Gather and shared gather on inputter
+		"testdata/bad.conf.9.yml", // Multiple shared gatherers, same names
 	}
 
 	for _, test := range tests {
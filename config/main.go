@@ -9,8 +9,9 @@ import (
 
 // MainCfg Temporal config to load the yaml configuration
 type MainCfg struct {
-	Global          Global          `yaml:"global"`
-	AutoscalerFiles AutoscalerFiles `yaml:"autoscaler_files"`
+	Global          Global           `yaml:"global"`
+	AutoscalerFiles AutoscalerFiles  `yaml:"autoscaler_files"`
+	SharedGatherers []SharedGatherer `yaml:"shared_gatherers,omitempty"`
 
 	// GlobalDefaults are the global defaults that will be applied if no data is set
 	GlobalDefaults *Global
@@ -25,6 +26,7 @@ func (m *MainCfg) Load(content []byte) (*Global, *AutoscalerFiles, error) {
 	d := *m.GlobalDefaults
 	m.Global = d
 	m.AutoscalerFiles = AutoscalerFiles{}
+	m.SharedGatherers = []SharedGatherer{}
 
 	if err := yaml.Unmarshal(content, m); err != nil {
 		return nil, nil, err
@@ -52,10 +54,37 @@ type Global struct {
 // AutoscalerFiles are the file path matchs of the autoscalers
 type AutoscalerFiles []string
 
+// SharedGatherer is a gatherer declared once that can be used by the inputters of multiple
+// autoscalers, its result will be cached for the TTL and shared between all of them
+type SharedGatherer struct {
+	Name  string        `yaml:"name"`
+	TTL   time.Duration `yaml:"ttl,omitempty"`
+	Block `yaml:",inline"`
+}
+
 // Check will check for requirements met after loading the config file
 func (m *MainCfg) check() error {
 	if len(m.AutoscalerFiles) == 0 {
 		return fmt.Errorf("Autoscaler files can't be empty")
 	}
+
+	// Check shared gatherers
+	names := map[string]struct{}{}
+	for _, sg := range m.SharedGatherers {
+		if sg.Name == "" {
+			return fmt.Errorf("Shared gatherer should have a name")
+		}
+		if _, ok := names[sg.Name]; ok {
+			return fmt.Errorf("Shared gatherer with name '%s' declared multiple times", sg.Name)
+		}
+		names[sg.Name] = struct{}{}
+
+		if sg.Kind == "" {
+			return fmt.Errorf("Shared gatherer '%s' kind missing", sg.Name)
+		}
+		if sg.TTL < 0 {
+			return fmt.Errorf("Shared gatherer '%s' TTL can't be negative", sg.Name)
+		}
+	}
 	return nil
 }
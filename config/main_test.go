@@ -147,6 +147,35 @@ global:
   warmup: 3m
   scaling_wait_timeout: 1m
 autoscaler_files:
+    `),
+		},
+		// Shared gatherer without kind
+		{
+			content: []byte(`
+shared_gatherers:
+  - name: render_jobs_queue
+autoscaler_files:
+  - testdata/as1/*.yml
+    `),
+		},
+		// Shared gatherer without name
+		{
+			content: []byte(`
+shared_gatherers:
+  - kind: dummy
+autoscaler_files:
+  - testdata/as1/*.yml
+    `),
+		},
+		// Shared gatherer with negative TTL
+		{
+			content: []byte(`
+shared_gatherers:
+  - name: render_jobs_queue
+    kind: dummy
+    ttl: -5s
+autoscaler_files:
+  - testdata/as1/*.yml
     `),
 		},
 	}
@@ -74,3 +74,51 @@ func MockGetMetricStatisticsError(t *testing.T, mockMatcher *sdk.MockCloudWatchA
 	log.Logger.Warningf("Mocking AWS iface: GetQueueAttributes")
 	mockMatcher.EXPECT().GetMetricStatistics(gomock.Any()).AnyTimes().Return(&cloudwatch.GetMetricStatisticsOutput{}, errors.New("Wrong!"))
 }
+
+// MockGetMetricData will mock GetMetricData cloudwatch API call, it will return the values
+// on the result with the id
+func MockGetMetricData(t *testing.T, mockMatcher *sdk.MockCloudWatchAPI, id string, values []float64) {
+	log.Logger.Warningf("Mocking AWS iface: GetMetricData")
+
+	result := &cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []*cloudwatch.MetricDataResult{
+			{
+				Id:         aws.String(id),
+				Label:      aws.String("fake"),
+				StatusCode: aws.String(cloudwatch.StatusCodeComplete),
+				Values:     aws.Float64Slice(values),
+			},
+		},
+	}
+	for i := range values {
+		result.MetricDataResults[0].Timestamps = append(result.MetricDataResults[0].Timestamps, aws.Time(time.Now().UTC().Add(time.Duration(-i)*time.Minute)))
+	}
+
+	// Mock as expected with our result
+	mockMatcher.EXPECT().GetMetricDataWithContext(gomock.Any(), gomock.Any()).Do(func(ctx interface{}, input interface{}) {
+		gotInput := input.(*cloudwatch.GetMetricDataInput)
+		// Check API received parameters are fine
+		if err := gotInput.Validate(); err != nil {
+			t.Fatalf("Wrong metric data input: %v", err)
+		}
+
+		returned := 0
+		for _, q := range gotInput.MetricDataQueries {
+			if aws.BoolValue(q.ReturnData) {
+				returned++
+				if aws.StringValue(q.Id) != id {
+					t.Fatalf("Wrong returned metric data query, want: %s; got: %s", id, aws.StringValue(q.Id))
+				}
+			}
+		}
+		if returned != 1 {
+			t.Fatalf("Only one metric data query should be returned, got: %d", returned)
+		}
+	}).AnyTimes().Return(result, nil)
+}
+
+// MockGetMetricDataError mocks the API call of getting an error from Cloudwatch GetMetricData
+func MockGetMetricDataError(t *testing.T, mockMatcher *sdk.MockCloudWatchAPI) {
+	log.Logger.Warningf("Mocking AWS iface: GetMetricData")
+	mockMatcher.EXPECT().GetMetricDataWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(&cloudwatch.GetMetricDataOutput{}, errors.New("Wrong!"))
+}
@@ -2,6 +2,8 @@ package aws
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -88,3 +90,140 @@ func MockECSDescribeClusters(t *testing.T, mockMatcher *sdk.MockECSAPI, numberOf
 		}
 	}).AnyTimes().Return(result, err)
 }
+
+// ECSInstanceResources are the CPU and memory resources of a mocked container instance
+type ECSInstanceResources struct {
+	RegisteredCPU    int64
+	RegisteredMemory int64
+	RemainingCPU     int64
+	RemainingMemory  int64
+}
+
+// ecsNextTokenMatcher matches the ECS list container instances calls of a page
+type ecsNextTokenMatcher string
+
+func (e ecsNextTokenMatcher) Matches(x interface{}) bool {
+	in, ok := x.(*ecs.ListContainerInstancesInput)
+	return ok && aws.StringValue(in.NextToken) == string(e)
+}
+
+func (e ecsNextTokenMatcher) String() string {
+	return "is page " + string(e)
+}
+
+// MockECSContainerInstances will mock the calls to AWS API listing (in pages of pageSize) and describing
+// the active container instances of a cluster
+func MockECSContainerInstances(t *testing.T, mockMatcher *sdk.MockECSAPI, clusterName string, instances []ECSInstanceResources, pageSize int, wantError bool) {
+	log.Logger.Warningf("Mocking AWS iface: ListContainerInstances")
+	var err error
+	if wantError {
+		err = errors.New("Wrong!")
+	}
+
+	cis := map[string]*ecs.ContainerInstance{}
+	arns := []*string{}
+	for i, ins := range instances {
+		arn := fmt.Sprintf("arn:aws:ecs:us-west-2:00000000000:container-instance/%d", i)
+		arns = append(arns, aws.String(arn))
+		cis[arn] = &ecs.ContainerInstance{
+			ContainerInstanceArn: aws.String(arn),
+			Status:               aws.String(ecs.ContainerInstanceStatusActive),
+			RegisteredResources: []*ecs.Resource{
+				{Name: aws.String("CPU"), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(ins.RegisteredCPU)},
+				{Name: aws.String("MEMORY"), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(ins.RegisteredMemory)},
+				{Name: aws.String("PORTS"), Type: aws.String("STRINGSET"), StringSetValue: aws.StringSlice([]string{"22"})},
+			},
+			RemainingResources: []*ecs.Resource{
+				{Name: aws.String("CPU"), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(ins.RemainingCPU)},
+				{Name: aws.String("MEMORY"), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(ins.RemainingMemory)},
+				{Name: aws.String("PORTS"), Type: aws.String("STRINGSET"), StringSetValue: aws.StringSlice([]string{"22"})},
+			},
+		}
+	}
+
+	// Mock each page of the listing, the next token is the index of the page start
+	if pageSize <= 0 {
+		pageSize = len(arns)
+	}
+	for i := 0; i == 0 || i < len(arns); i += pageSize {
+		end := i + pageSize
+		result := &ecs.ListContainerInstancesOutput{}
+		if end < len(arns) {
+			result.NextToken = aws.String(strconv.Itoa(end))
+		} else {
+			end = len(arns)
+		}
+		result.ContainerInstanceArns = arns[i:end]
+
+		token := ""
+		if i > 0 {
+			token = strconv.Itoa(i)
+		}
+		mockMatcher.EXPECT().ListContainerInstancesWithContext(gomock.Any(), ecsNextTokenMatcher(token)).Do(func(ctx interface{}, input interface{}) {
+			gotInput := input.(*ecs.ListContainerInstancesInput)
+			// Check API received parameters are fine
+			if aws.StringValue(gotInput.Cluster) != clusterName {
+				t.Fatalf("Wrong cluster name, got %s; want %s", aws.StringValue(gotInput.Cluster), clusterName)
+			}
+			if aws.StringValue(gotInput.Status) != ecs.ContainerInstanceStatusActive {
+				t.Fatalf("Expected active container instances, got %s", aws.StringValue(gotInput.Status))
+			}
+		}).AnyTimes().Return(result, err)
+
+		if pageSize == 0 {
+			break
+		}
+	}
+
+	log.Logger.Warningf("Mocking AWS iface: DescribeContainerInstances")
+	// Describe returns the requested instances, use a new mocked call per batch
+	for i := 0; i < len(arns); i += 100 {
+		end := i + 100
+		if end > len(arns) {
+			end = len(arns)
+		}
+		result := &ecs.DescribeContainerInstancesOutput{}
+		for _, arn := range arns[i:end] {
+			result.ContainerInstances = append(result.ContainerInstances, cis[aws.StringValue(arn)])
+		}
+		mockMatcher.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), &ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(clusterName),
+			ContainerInstances: arns[i:end],
+		}).AnyTimes().Return(result, err)
+	}
+}
+
+// MockECSDescribeTaskDefinition will mock a call to AWS API describing a task definition with containers
+// of cpu and memory (memory reservation if soft is true) reservations
+func MockECSDescribeTaskDefinition(t *testing.T, mockMatcher *sdk.MockECSAPI, taskDefinition string, cpus, memories []int64, soft bool, wantError bool) {
+	log.Logger.Warningf("Mocking AWS iface: DescribeTaskDefinition")
+	var err error
+	if wantError {
+		err = errors.New("Wrong!")
+	}
+
+	td := &ecs.TaskDefinition{
+		Family: aws.String(taskDefinition),
+	}
+	for i := range cpus {
+		cd := &ecs.ContainerDefinition{
+			Name: aws.String(fmt.Sprintf("container%d", i)),
+			Cpu:  aws.Int64(cpus[i]),
+		}
+		if soft {
+			cd.MemoryReservation = aws.Int64(memories[i])
+		} else {
+			cd.Memory = aws.Int64(memories[i])
+		}
+		td.ContainerDefinitions = append(td.ContainerDefinitions, cd)
+	}
+	result := &ecs.DescribeTaskDefinitionOutput{TaskDefinition: td}
+
+	mockMatcher.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), gomock.Any()).Do(func(ctx interface{}, input interface{}) {
+		gotInput := input.(*ecs.DescribeTaskDefinitionInput)
+		// Check API received parameters are fine
+		if aws.StringValue(gotInput.TaskDefinition) != taskDefinition {
+			t.Fatalf("Wrong task definition, got %s; want %s", aws.StringValue(gotInput.TaskDefinition), taskDefinition)
+		}
+	}).AnyTimes().Return(result, err)
+}
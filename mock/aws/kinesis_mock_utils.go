@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/golang/mock/gomock"
+
+	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/mock/aws/sdk"
+)
+
+// MockDescribeStreamSummary will mock DescribeStreamSummary kinesis API call returning the
+// open shards
+func MockDescribeStreamSummary(t *testing.T, mockMatcher *sdk.MockKinesisAPI, streamName string, openShards int64) {
+	log.Logger.Warningf("Mocking AWS iface: DescribeStreamSummary")
+
+	result := &kinesis.DescribeStreamSummaryOutput{
+		StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{
+			StreamName:     aws.String(streamName),
+			StreamStatus:   aws.String(kinesis.StreamStatusActive),
+			OpenShardCount: aws.Int64(openShards),
+		},
+	}
+
+	// Mock as expected with our result
+	mockMatcher.EXPECT().DescribeStreamSummaryWithContext(gomock.Any(), gomock.Any()).Do(func(ctx interface{}, input interface{}) {
+		gotInput := input.(*kinesis.DescribeStreamSummaryInput)
+		// Check API received parameters are fine
+		if aws.StringValue(gotInput.StreamName) != streamName {
+			t.Fatalf("Wrong stream name, want: %s; got: %s", streamName, aws.StringValue(gotInput.StreamName))
+		}
+	}).AnyTimes().Return(result, nil)
+}
+
+// MockDescribeStreamSummaryError mocks the API call of getting an error from kinesis DescribeStreamSummary
+func MockDescribeStreamSummaryError(t *testing.T, mockMatcher *sdk.MockKinesisAPI) {
+	log.Logger.Warningf("Mocking AWS iface: DescribeStreamSummary")
+	mockMatcher.EXPECT().DescribeStreamSummaryWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(&kinesis.DescribeStreamSummaryOutput{}, errors.New("Wrong!"))
+}
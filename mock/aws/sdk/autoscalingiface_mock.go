@@ -4,6 +4,7 @@
 package sdk
 
 import (
+	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	autoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
 	gomock "github.com/golang/mock/gomock"
@@ -30,6 +31,33 @@ func (_m *MockAutoScalingAPI) EXPECT() *_MockAutoScalingAPIRecorder {
 	return _m.recorder
 }
 
+func (_m *MockAutoScalingAPI) AttachInstances(_param0 *autoscaling.AttachInstancesInput) (*autoscaling.AttachInstancesOutput, error) {
+	ret := _m.ctrl.Call(_m, "AttachInstances", _param0)
+	ret0, _ := ret[0].(*autoscaling.AttachInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachInstances(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstances", arg0)
+}
+
+func (_m *MockAutoScalingAPI) AttachInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.AttachInstancesInput, _param2 ...request.Option) (*autoscaling.AttachInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstancesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) AttachInstancesRequest(_param0 *autoscaling.AttachInstancesInput) (*request.Request, *autoscaling.AttachInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "AttachInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -41,26 +69,42 @@ func (_mr *_MockAutoScalingAPIRecorder) AttachInstancesRequest(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstancesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) AttachInstances(_param0 *autoscaling.AttachInstancesInput) (*autoscaling.AttachInstancesOutput, error) {
-	ret := _m.ctrl.Call(_m, "AttachInstances", _param0)
-	ret0, _ := ret[0].(*autoscaling.AttachInstancesOutput)
+func (_m *MockAutoScalingAPI) AttachLoadBalancerTargetGroups(_param0 *autoscaling.AttachLoadBalancerTargetGroupsInput) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancerTargetGroups", _param0)
+	ret0, _ := ret[0].(*autoscaling.AttachLoadBalancerTargetGroupsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) AttachInstances(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstances", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancerTargetGroups(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancerTargetGroups", arg0)
 }
 
-func (_m *MockAutoScalingAPI) AttachLoadBalancersRequest(_param0 *autoscaling.AttachLoadBalancersInput) (*request.Request, *autoscaling.AttachLoadBalancersOutput) {
-	ret := _m.ctrl.Call(_m, "AttachLoadBalancersRequest", _param0)
+func (_m *MockAutoScalingAPI) AttachLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.AttachLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancerTargetGroupsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) AttachLoadBalancerTargetGroupsRequest(_param0 *autoscaling.AttachLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.AttachLoadBalancerTargetGroupsOutput) {
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.AttachLoadBalancersOutput)
+	ret1, _ := ret[1].(*autoscaling.AttachLoadBalancerTargetGroupsOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancersRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancersRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancerTargetGroupsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancerTargetGroupsRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) AttachLoadBalancers(_param0 *autoscaling.AttachLoadBalancersInput) (*autoscaling.AttachLoadBalancersOutput, error) {
@@ -74,15 +118,107 @@ func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancers(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancers", arg0)
 }
 
-func (_m *MockAutoScalingAPI) CompleteLifecycleActionRequest(_param0 *autoscaling.CompleteLifecycleActionInput) (*request.Request, *autoscaling.CompleteLifecycleActionOutput) {
-	ret := _m.ctrl.Call(_m, "CompleteLifecycleActionRequest", _param0)
+func (_m *MockAutoScalingAPI) AttachLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.AttachLoadBalancersInput, _param2 ...request.Option) (*autoscaling.AttachLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancersWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) AttachLoadBalancersRequest(_param0 *autoscaling.AttachLoadBalancersInput) (*request.Request, *autoscaling.AttachLoadBalancersOutput) {
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.CompleteLifecycleActionOutput)
+	ret1, _ := ret[1].(*autoscaling.AttachLoadBalancersOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleActionRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleActionRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancersRequest", arg0)
+}
+
+func (_m *MockAutoScalingAPI) BatchDeleteScheduledAction(_param0 *autoscaling.BatchDeleteScheduledActionInput) (*autoscaling.BatchDeleteScheduledActionOutput, error) {
+	ret := _m.ctrl.Call(_m, "BatchDeleteScheduledAction", _param0)
+	ret0, _ := ret[0].(*autoscaling.BatchDeleteScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchDeleteScheduledAction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchDeleteScheduledAction", arg0)
+}
+
+func (_m *MockAutoScalingAPI) BatchDeleteScheduledActionWithContext(_param0 aws.Context, _param1 *autoscaling.BatchDeleteScheduledActionInput, _param2 ...request.Option) (*autoscaling.BatchDeleteScheduledActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "BatchDeleteScheduledActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.BatchDeleteScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchDeleteScheduledActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchDeleteScheduledActionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) BatchDeleteScheduledActionRequest(_param0 *autoscaling.BatchDeleteScheduledActionInput) (*request.Request, *autoscaling.BatchDeleteScheduledActionOutput) {
+	ret := _m.ctrl.Call(_m, "BatchDeleteScheduledActionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.BatchDeleteScheduledActionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchDeleteScheduledActionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchDeleteScheduledActionRequest", arg0)
+}
+
+func (_m *MockAutoScalingAPI) BatchPutScheduledUpdateGroupAction(_param0 *autoscaling.BatchPutScheduledUpdateGroupActionInput) (*autoscaling.BatchPutScheduledUpdateGroupActionOutput, error) {
+	ret := _m.ctrl.Call(_m, "BatchPutScheduledUpdateGroupAction", _param0)
+	ret0, _ := ret[0].(*autoscaling.BatchPutScheduledUpdateGroupActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchPutScheduledUpdateGroupAction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchPutScheduledUpdateGroupAction", arg0)
+}
+
+func (_m *MockAutoScalingAPI) BatchPutScheduledUpdateGroupActionWithContext(_param0 aws.Context, _param1 *autoscaling.BatchPutScheduledUpdateGroupActionInput, _param2 ...request.Option) (*autoscaling.BatchPutScheduledUpdateGroupActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "BatchPutScheduledUpdateGroupActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.BatchPutScheduledUpdateGroupActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchPutScheduledUpdateGroupActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchPutScheduledUpdateGroupActionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) BatchPutScheduledUpdateGroupActionRequest(_param0 *autoscaling.BatchPutScheduledUpdateGroupActionInput) (*request.Request, *autoscaling.BatchPutScheduledUpdateGroupActionOutput) {
+	ret := _m.ctrl.Call(_m, "BatchPutScheduledUpdateGroupActionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.BatchPutScheduledUpdateGroupActionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) BatchPutScheduledUpdateGroupActionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BatchPutScheduledUpdateGroupActionRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) CompleteLifecycleAction(_param0 *autoscaling.CompleteLifecycleActionInput) (*autoscaling.CompleteLifecycleActionOutput, error) {
@@ -96,15 +232,31 @@ func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleAction(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleAction", arg0)
 }
 
-func (_m *MockAutoScalingAPI) CreateAutoScalingGroupRequest(_param0 *autoscaling.CreateAutoScalingGroupInput) (*request.Request, *autoscaling.CreateAutoScalingGroupOutput) {
-	ret := _m.ctrl.Call(_m, "CreateAutoScalingGroupRequest", _param0)
+func (_m *MockAutoScalingAPI) CompleteLifecycleActionWithContext(_param0 aws.Context, _param1 *autoscaling.CompleteLifecycleActionInput, _param2 ...request.Option) (*autoscaling.CompleteLifecycleActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CompleteLifecycleActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CompleteLifecycleActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleActionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) CompleteLifecycleActionRequest(_param0 *autoscaling.CompleteLifecycleActionInput) (*request.Request, *autoscaling.CompleteLifecycleActionOutput) {
+	ret := _m.ctrl.Call(_m, "CompleteLifecycleActionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.CreateAutoScalingGroupOutput)
+	ret1, _ := ret[1].(*autoscaling.CompleteLifecycleActionOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroupRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroupRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleActionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleActionRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) CreateAutoScalingGroup(_param0 *autoscaling.CreateAutoScalingGroupInput) (*autoscaling.CreateAutoScalingGroupOutput, error) {
@@ -118,15 +270,31 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroup(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroup", arg0)
 }
 
-func (_m *MockAutoScalingAPI) CreateLaunchConfigurationRequest(_param0 *autoscaling.CreateLaunchConfigurationInput) (*request.Request, *autoscaling.CreateLaunchConfigurationOutput) {
-	ret := _m.ctrl.Call(_m, "CreateLaunchConfigurationRequest", _param0)
+func (_m *MockAutoScalingAPI) CreateAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.CreateAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroupWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) CreateAutoScalingGroupRequest(_param0 *autoscaling.CreateAutoScalingGroupInput) (*request.Request, *autoscaling.CreateAutoScalingGroupOutput) {
+	ret := _m.ctrl.Call(_m, "CreateAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.CreateLaunchConfigurationOutput)
+	ret1, _ := ret[1].(*autoscaling.CreateAutoScalingGroupOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfigurationRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfigurationRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroupRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) CreateLaunchConfiguration(_param0 *autoscaling.CreateLaunchConfigurationInput) (*autoscaling.CreateLaunchConfigurationOutput, error) {
@@ -140,15 +308,31 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfiguration(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfiguration", arg0)
 }
 
-func (_m *MockAutoScalingAPI) CreateOrUpdateTagsRequest(_param0 *autoscaling.CreateOrUpdateTagsInput) (*request.Request, *autoscaling.CreateOrUpdateTagsOutput) {
-	ret := _m.ctrl.Call(_m, "CreateOrUpdateTagsRequest", _param0)
+func (_m *MockAutoScalingAPI) CreateLaunchConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.CreateLaunchConfigurationInput, _param2 ...request.Option) (*autoscaling.CreateLaunchConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateLaunchConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateLaunchConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfigurationWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) CreateLaunchConfigurationRequest(_param0 *autoscaling.CreateLaunchConfigurationInput) (*request.Request, *autoscaling.CreateLaunchConfigurationOutput) {
+	ret := _m.ctrl.Call(_m, "CreateLaunchConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.CreateOrUpdateTagsOutput)
+	ret1, _ := ret[1].(*autoscaling.CreateLaunchConfigurationOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTagsRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTagsRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfigurationRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) CreateOrUpdateTags(_param0 *autoscaling.CreateOrUpdateTagsInput) (*autoscaling.CreateOrUpdateTagsOutput, error) {
@@ -162,15 +346,31 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTags(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTags", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteAutoScalingGroupRequest(_param0 *autoscaling.DeleteAutoScalingGroupInput) (*request.Request, *autoscaling.DeleteAutoScalingGroupOutput) {
-	ret := _m.ctrl.Call(_m, "DeleteAutoScalingGroupRequest", _param0)
+func (_m *MockAutoScalingAPI) CreateOrUpdateTagsWithContext(_param0 aws.Context, _param1 *autoscaling.CreateOrUpdateTagsInput, _param2 ...request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateOrUpdateTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateOrUpdateTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTagsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) CreateOrUpdateTagsRequest(_param0 *autoscaling.CreateOrUpdateTagsInput) (*request.Request, *autoscaling.CreateOrUpdateTagsOutput) {
+	ret := _m.ctrl.Call(_m, "CreateOrUpdateTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeleteAutoScalingGroupOutput)
+	ret1, _ := ret[1].(*autoscaling.CreateOrUpdateTagsOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroupRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroupRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTagsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTagsRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeleteAutoScalingGroup(_param0 *autoscaling.DeleteAutoScalingGroupInput) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
@@ -184,15 +384,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroup(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroup", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteLaunchConfigurationRequest(_param0 *autoscaling.DeleteLaunchConfigurationInput) (*request.Request, *autoscaling.DeleteLaunchConfigurationOutput) {
-	ret := _m.ctrl.Call(_m, "DeleteLaunchConfigurationRequest", _param0)
+func (_m *MockAutoScalingAPI) DeleteAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroupWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeleteAutoScalingGroupRequest(_param0 *autoscaling.DeleteAutoScalingGroupInput) (*request.Request, *autoscaling.DeleteAutoScalingGroupOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeleteLaunchConfigurationOutput)
+	ret1, _ := ret[1].(*autoscaling.DeleteAutoScalingGroupOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfigurationRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfigurationRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroupRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeleteLaunchConfiguration(_param0 *autoscaling.DeleteLaunchConfigurationInput) (*autoscaling.DeleteLaunchConfigurationOutput, error) {
@@ -206,15 +422,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfiguration(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfiguration", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteLifecycleHookRequest(_param0 *autoscaling.DeleteLifecycleHookInput) (*request.Request, *autoscaling.DeleteLifecycleHookOutput) {
-	ret := _m.ctrl.Call(_m, "DeleteLifecycleHookRequest", _param0)
+func (_m *MockAutoScalingAPI) DeleteLaunchConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteLaunchConfigurationInput, _param2 ...request.Option) (*autoscaling.DeleteLaunchConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLaunchConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteLaunchConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfigurationWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeleteLaunchConfigurationRequest(_param0 *autoscaling.DeleteLaunchConfigurationInput) (*request.Request, *autoscaling.DeleteLaunchConfigurationOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteLaunchConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeleteLifecycleHookOutput)
+	ret1, _ := ret[1].(*autoscaling.DeleteLaunchConfigurationOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHookRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHookRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfigurationRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeleteLifecycleHook(_param0 *autoscaling.DeleteLifecycleHookInput) (*autoscaling.DeleteLifecycleHookOutput, error) {
@@ -228,15 +460,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHook(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHook", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteNotificationConfigurationRequest(_param0 *autoscaling.DeleteNotificationConfigurationInput) (*request.Request, *autoscaling.DeleteNotificationConfigurationOutput) {
-	ret := _m.ctrl.Call(_m, "DeleteNotificationConfigurationRequest", _param0)
+func (_m *MockAutoScalingAPI) DeleteLifecycleHookWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteLifecycleHookInput, _param2 ...request.Option) (*autoscaling.DeleteLifecycleHookOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLifecycleHookWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteLifecycleHookOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHookWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHookWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeleteLifecycleHookRequest(_param0 *autoscaling.DeleteLifecycleHookInput) (*request.Request, *autoscaling.DeleteLifecycleHookOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteLifecycleHookRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeleteNotificationConfigurationOutput)
+	ret1, _ := ret[1].(*autoscaling.DeleteLifecycleHookOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfigurationRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfigurationRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHookRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHookRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeleteNotificationConfiguration(_param0 *autoscaling.DeleteNotificationConfigurationInput) (*autoscaling.DeleteNotificationConfigurationOutput, error) {
@@ -250,15 +498,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfiguration(arg0 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfiguration", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeletePolicyRequest(_param0 *autoscaling.DeletePolicyInput) (*request.Request, *autoscaling.DeletePolicyOutput) {
-	ret := _m.ctrl.Call(_m, "DeletePolicyRequest", _param0)
+func (_m *MockAutoScalingAPI) DeleteNotificationConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteNotificationConfigurationInput, _param2 ...request.Option) (*autoscaling.DeleteNotificationConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNotificationConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteNotificationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfigurationWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeleteNotificationConfigurationRequest(_param0 *autoscaling.DeleteNotificationConfigurationInput) (*request.Request, *autoscaling.DeleteNotificationConfigurationOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteNotificationConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeletePolicyOutput)
+	ret1, _ := ret[1].(*autoscaling.DeleteNotificationConfigurationOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeletePolicyRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicyRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfigurationRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeletePolicy(_param0 *autoscaling.DeletePolicyInput) (*autoscaling.DeletePolicyOutput, error) {
@@ -272,15 +536,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeletePolicy(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicy", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteScheduledActionRequest(_param0 *autoscaling.DeleteScheduledActionInput) (*request.Request, *autoscaling.DeleteScheduledActionOutput) {
-	ret := _m.ctrl.Call(_m, "DeleteScheduledActionRequest", _param0)
+func (_m *MockAutoScalingAPI) DeletePolicyWithContext(_param0 aws.Context, _param1 *autoscaling.DeletePolicyInput, _param2 ...request.Option) (*autoscaling.DeletePolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeletePolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeletePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeletePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicyWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeletePolicyRequest(_param0 *autoscaling.DeletePolicyInput) (*request.Request, *autoscaling.DeletePolicyOutput) {
+	ret := _m.ctrl.Call(_m, "DeletePolicyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DeleteScheduledActionOutput)
+	ret1, _ := ret[1].(*autoscaling.DeletePolicyOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledActionRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledActionRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DeletePolicyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicyRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DeleteScheduledAction(_param0 *autoscaling.DeleteScheduledActionInput) (*autoscaling.DeleteScheduledActionOutput, error) {
@@ -294,6 +574,60 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledAction(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledAction", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteScheduledActionWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteScheduledActionInput, _param2 ...request.Option) (*autoscaling.DeleteScheduledActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteScheduledActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledActionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DeleteScheduledActionRequest(_param0 *autoscaling.DeleteScheduledActionInput) (*request.Request, *autoscaling.DeleteScheduledActionOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteScheduledActionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DeleteScheduledActionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledActionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledActionRequest", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DeleteTags(_param0 *autoscaling.DeleteTagsInput) (*autoscaling.DeleteTagsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteTags", _param0)
+	ret0, _ := ret[0].(*autoscaling.DeleteTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteTags(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTags", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DeleteTagsWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteTagsInput, _param2 ...request.Option) (*autoscaling.DeleteTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTagsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteTagsRequest(_param0 *autoscaling.DeleteTagsInput) (*request.Request, *autoscaling.DeleteTagsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -305,15 +639,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteTagsRequest(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTagsRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DeleteTags(_param0 *autoscaling.DeleteTagsInput) (*autoscaling.DeleteTagsOutput, error) {
-	ret := _m.ctrl.Call(_m, "DeleteTags", _param0)
-	ret0, _ := ret[0].(*autoscaling.DeleteTagsOutput)
+func (_m *MockAutoScalingAPI) DescribeAccountLimits(_param0 *autoscaling.DescribeAccountLimitsInput) (*autoscaling.DescribeAccountLimitsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAccountLimits", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeAccountLimitsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimits(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimits", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeAccountLimitsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAccountLimitsInput, _param2 ...request.Option) (*autoscaling.DescribeAccountLimitsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAccountLimitsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAccountLimitsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DeleteTags(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTags", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimitsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimitsWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeAccountLimitsRequest(_param0 *autoscaling.DescribeAccountLimitsInput) (*request.Request, *autoscaling.DescribeAccountLimitsOutput) {
@@ -327,15 +677,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimitsRequest(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimitsRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeAccountLimits(_param0 *autoscaling.DescribeAccountLimitsInput) (*autoscaling.DescribeAccountLimitsOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeAccountLimits", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeAccountLimitsOutput)
+func (_m *MockAutoScalingAPI) DescribeAdjustmentTypes(_param0 *autoscaling.DescribeAdjustmentTypesInput) (*autoscaling.DescribeAdjustmentTypesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAdjustmentTypes", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeAdjustmentTypesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimits(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimits", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypes(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypes", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeAdjustmentTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAdjustmentTypesInput, _param2 ...request.Option) (*autoscaling.DescribeAdjustmentTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAdjustmentTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAdjustmentTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeAdjustmentTypesRequest(_param0 *autoscaling.DescribeAdjustmentTypesInput) (*request.Request, *autoscaling.DescribeAdjustmentTypesOutput) {
@@ -349,15 +715,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypesRequest(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeAdjustmentTypes(_param0 *autoscaling.DescribeAdjustmentTypesInput) (*autoscaling.DescribeAdjustmentTypesOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeAdjustmentTypes", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeAdjustmentTypesOutput)
+func (_m *MockAutoScalingAPI) DescribeAutoScalingGroups(_param0 *autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroups", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingGroupsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypes(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypes", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroups(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroups", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsRequest(_param0 *autoscaling.DescribeAutoScalingGroupsInput) (*request.Request, *autoscaling.DescribeAutoScalingGroupsOutput) {
@@ -371,17 +753,6 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsRequest(arg0 in
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeAutoScalingGroups(_param0 *autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroups", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingGroupsOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroups(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroups", arg0)
-}
-
 func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsPages(_param0 *autoscaling.DescribeAutoScalingGroupsInput, _param1 func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -392,15 +763,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsPages(arg0, arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesRequest(_param0 *autoscaling.DescribeAutoScalingInstancesInput) (*request.Request, *autoscaling.DescribeAutoScalingInstancesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeAutoScalingInstancesOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeAutoScalingInstances(_param0 *autoscaling.DescribeAutoScalingInstancesInput) (*autoscaling.DescribeAutoScalingInstancesOutput, error) {
@@ -414,6 +789,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstances(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstances", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingInstancesInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesRequest(_param0 *autoscaling.DescribeAutoScalingInstancesInput) (*request.Request, *autoscaling.DescribeAutoScalingInstancesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeAutoScalingInstancesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesPages(_param0 *autoscaling.DescribeAutoScalingInstancesInput, _param1 func(*autoscaling.DescribeAutoScalingInstancesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -424,15 +826,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesPages(arg0,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypesRequest(_param0 *autoscaling.DescribeAutoScalingNotificationTypesInput) (*request.Request, *autoscaling.DescribeAutoScalingNotificationTypesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeAutoScalingNotificationTypesRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeAutoScalingNotificationTypesOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingInstancesInput, _param2 func(*autoscaling.DescribeAutoScalingInstancesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypes(_param0 *autoscaling.DescribeAutoScalingNotificationTypesInput) (*autoscaling.DescribeAutoScalingNotificationTypesOutput, error) {
@@ -446,15 +852,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypes(arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypes", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsRequest(_param0 *autoscaling.DescribeLaunchConfigurationsInput) (*request.Request, *autoscaling.DescribeLaunchConfigurationsOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsRequest", _param0)
+func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingNotificationTypesInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingNotificationTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingNotificationTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingNotificationTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypesRequest(_param0 *autoscaling.DescribeAutoScalingNotificationTypesInput) (*request.Request, *autoscaling.DescribeAutoScalingNotificationTypesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingNotificationTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeLaunchConfigurationsOutput)
+	ret1, _ := ret[1].(*autoscaling.DescribeAutoScalingNotificationTypesOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypesRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribeLaunchConfigurations(_param0 *autoscaling.DescribeLaunchConfigurationsInput) (*autoscaling.DescribeLaunchConfigurationsOutput, error) {
@@ -468,6 +890,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurations(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurations", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLaunchConfigurationsInput, _param2 ...request.Option) (*autoscaling.DescribeLaunchConfigurationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLaunchConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsRequest(_param0 *autoscaling.DescribeLaunchConfigurationsInput) (*request.Request, *autoscaling.DescribeLaunchConfigurationsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeLaunchConfigurationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsPages(_param0 *autoscaling.DescribeLaunchConfigurationsInput, _param1 func(*autoscaling.DescribeLaunchConfigurationsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -478,6 +927,48 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsPages(arg0,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLaunchConfigurationsInput, _param2 func(*autoscaling.DescribeLaunchConfigurationsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsPagesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypes(_param0 *autoscaling.DescribeLifecycleHookTypesInput) (*autoscaling.DescribeLifecycleHookTypesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypes", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHookTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypes(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypes", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLifecycleHookTypesInput, _param2 ...request.Option) (*autoscaling.DescribeLifecycleHookTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHookTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypesRequest(_param0 *autoscaling.DescribeLifecycleHookTypesInput) (*request.Request, *autoscaling.DescribeLifecycleHookTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -489,15 +980,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypesRequest(arg0 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypes(_param0 *autoscaling.DescribeLifecycleHookTypesInput) (*autoscaling.DescribeLifecycleHookTypesOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypes", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHookTypesOutput)
+func (_m *MockAutoScalingAPI) DescribeLifecycleHooks(_param0 *autoscaling.DescribeLifecycleHooksInput) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHooks", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHooksOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypes(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypes", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooks(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooks", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLifecycleHooksWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLifecycleHooksInput, _param2 ...request.Option) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHooksWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHooksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooksWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeLifecycleHooksRequest(_param0 *autoscaling.DescribeLifecycleHooksInput) (*request.Request, *autoscaling.DescribeLifecycleHooksOutput) {
@@ -511,26 +1018,42 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooksRequest(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooksRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeLifecycleHooks(_param0 *autoscaling.DescribeLifecycleHooksInput) (*autoscaling.DescribeLifecycleHooksOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeLifecycleHooks", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHooksOutput)
+func (_m *MockAutoScalingAPI) DescribeLoadBalancerTargetGroups(_param0 *autoscaling.DescribeLoadBalancerTargetGroupsInput) (*autoscaling.DescribeLoadBalancerTargetGroupsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerTargetGroups", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeLoadBalancerTargetGroupsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooks(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooks", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancerTargetGroups(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerTargetGroups", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeLoadBalancersRequest(_param0 *autoscaling.DescribeLoadBalancersInput) (*request.Request, *autoscaling.DescribeLoadBalancersOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersRequest", _param0)
+func (_m *MockAutoScalingAPI) DescribeLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.DescribeLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerTargetGroupsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLoadBalancerTargetGroupsRequest(_param0 *autoscaling.DescribeLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DescribeLoadBalancerTargetGroupsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(*autoscaling.DescribeLoadBalancerTargetGroupsOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancersRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancerTargetGroupsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerTargetGroupsRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribeLoadBalancers(_param0 *autoscaling.DescribeLoadBalancersInput) (*autoscaling.DescribeLoadBalancersOutput, error) {
@@ -544,15 +1067,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancers(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancers", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypesRequest(_param0 *autoscaling.DescribeMetricCollectionTypesInput) (*request.Request, *autoscaling.DescribeMetricCollectionTypesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeMetricCollectionTypesRequest", _param0)
+func (_m *MockAutoScalingAPI) DescribeLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLoadBalancersInput, _param2 ...request.Option) (*autoscaling.DescribeLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeLoadBalancersRequest(_param0 *autoscaling.DescribeLoadBalancersInput) (*request.Request, *autoscaling.DescribeLoadBalancersOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeMetricCollectionTypesOutput)
+	ret1, _ := ret[1].(*autoscaling.DescribeLoadBalancersOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypes(_param0 *autoscaling.DescribeMetricCollectionTypesInput) (*autoscaling.DescribeMetricCollectionTypesOutput, error) {
@@ -566,15 +1105,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypes(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypes", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsRequest(_param0 *autoscaling.DescribeNotificationConfigurationsInput) (*request.Request, *autoscaling.DescribeNotificationConfigurationsOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsRequest", _param0)
+func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeMetricCollectionTypesInput, _param2 ...request.Option) (*autoscaling.DescribeMetricCollectionTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeMetricCollectionTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeMetricCollectionTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypesRequest(_param0 *autoscaling.DescribeMetricCollectionTypesInput) (*request.Request, *autoscaling.DescribeMetricCollectionTypesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMetricCollectionTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeNotificationConfigurationsOutput)
+	ret1, _ := ret[1].(*autoscaling.DescribeMetricCollectionTypesOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypesRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribeNotificationConfigurations(_param0 *autoscaling.DescribeNotificationConfigurationsInput) (*autoscaling.DescribeNotificationConfigurationsOutput, error) {
@@ -588,6 +1143,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurations(arg0
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurations", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeNotificationConfigurationsInput, _param2 ...request.Option) (*autoscaling.DescribeNotificationConfigurationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeNotificationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsRequest(_param0 *autoscaling.DescribeNotificationConfigurationsInput) (*request.Request, *autoscaling.DescribeNotificationConfigurationsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeNotificationConfigurationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsPages(_param0 *autoscaling.DescribeNotificationConfigurationsInput, _param1 func(*autoscaling.DescribeNotificationConfigurationsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -598,15 +1180,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsPages(
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribePoliciesRequest(_param0 *autoscaling.DescribePoliciesInput) (*request.Request, *autoscaling.DescribePoliciesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribePoliciesRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribePoliciesOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeNotificationConfigurationsInput, _param2 func(*autoscaling.DescribeNotificationConfigurationsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribePolicies(_param0 *autoscaling.DescribePoliciesInput) (*autoscaling.DescribePoliciesOutput, error) {
@@ -616,8 +1202,35 @@ func (_m *MockAutoScalingAPI) DescribePolicies(_param0 *autoscaling.DescribePoli
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribePolicies(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePolicies", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribePolicies(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePolicies", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribePoliciesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribePoliciesInput, _param2 ...request.Option) (*autoscaling.DescribePoliciesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePoliciesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribePoliciesRequest(_param0 *autoscaling.DescribePoliciesInput) (*request.Request, *autoscaling.DescribePoliciesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribePoliciesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribePoliciesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribePoliciesPages(_param0 *autoscaling.DescribePoliciesInput, _param1 func(*autoscaling.DescribePoliciesOutput, bool) bool) error {
@@ -630,15 +1243,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesPages(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribeScalingActivitiesRequest(_param0 *autoscaling.DescribeScalingActivitiesInput) (*request.Request, *autoscaling.DescribeScalingActivitiesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeScalingActivitiesOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribePoliciesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribePoliciesInput, _param2 func(*autoscaling.DescribePoliciesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePoliciesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeScalingActivities(_param0 *autoscaling.DescribeScalingActivitiesInput) (*autoscaling.DescribeScalingActivitiesOutput, error) {
@@ -652,6 +1269,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivities(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivities", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScalingActivitiesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingActivitiesInput, _param2 ...request.Option) (*autoscaling.DescribeScalingActivitiesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScalingActivitiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeScalingActivitiesRequest(_param0 *autoscaling.DescribeScalingActivitiesInput) (*request.Request, *autoscaling.DescribeScalingActivitiesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeScalingActivitiesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScalingActivitiesPages(_param0 *autoscaling.DescribeScalingActivitiesInput, _param1 func(*autoscaling.DescribeScalingActivitiesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -662,15 +1306,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesPages(arg0, arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribeScalingProcessTypesRequest(_param0 *autoscaling.DescribeScalingProcessTypesInput) (*request.Request, *autoscaling.DescribeScalingProcessTypesOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeScalingProcessTypesRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeScalingProcessTypesOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribeScalingActivitiesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingActivitiesInput, _param2 func(*autoscaling.DescribeScalingActivitiesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypesRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypesRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeScalingProcessTypes(_param0 *autoscaling.DescribeScalingProcessTypesInput) (*autoscaling.DescribeScalingProcessTypesOutput, error) {
@@ -684,15 +1332,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypes(arg0 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypes", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeScheduledActionsRequest(_param0 *autoscaling.DescribeScheduledActionsInput) (*request.Request, *autoscaling.DescribeScheduledActionsOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsRequest", _param0)
+func (_m *MockAutoScalingAPI) DescribeScalingProcessTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingProcessTypesInput, _param2 ...request.Option) (*autoscaling.DescribeScalingProcessTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingProcessTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScalingProcessTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeScalingProcessTypesRequest(_param0 *autoscaling.DescribeScalingProcessTypesInput) (*request.Request, *autoscaling.DescribeScalingProcessTypesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeScalingProcessTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeScheduledActionsOutput)
+	ret1, _ := ret[1].(*autoscaling.DescribeScalingProcessTypesOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypesRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DescribeScheduledActions(_param0 *autoscaling.DescribeScheduledActionsInput) (*autoscaling.DescribeScheduledActionsOutput, error) {
@@ -706,6 +1370,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActions(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActions", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScheduledActionsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScheduledActionsInput, _param2 ...request.Option) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScheduledActionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeScheduledActionsRequest(_param0 *autoscaling.DescribeScheduledActionsInput) (*request.Request, *autoscaling.DescribeScheduledActionsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeScheduledActionsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScheduledActionsPages(_param0 *autoscaling.DescribeScheduledActionsInput, _param1 func(*autoscaling.DescribeScheduledActionsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -716,15 +1407,19 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsPages(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsPages", arg0, arg1)
 }
 
-func (_m *MockAutoScalingAPI) DescribeTagsRequest(_param0 *autoscaling.DescribeTagsInput) (*request.Request, *autoscaling.DescribeTagsOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DescribeTagsOutput)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) DescribeScheduledActionsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScheduledActionsInput, _param2 func(*autoscaling.DescribeScheduledActionsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsPagesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DescribeTags(_param0 *autoscaling.DescribeTagsInput) (*autoscaling.DescribeTagsOutput, error) {
@@ -738,6 +1433,33 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTags(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTags", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeTagsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTagsInput, _param2 ...request.Option) (*autoscaling.DescribeTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeTagsRequest(_param0 *autoscaling.DescribeTagsInput) (*request.Request, *autoscaling.DescribeTagsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.DescribeTagsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsRequest", arg0)
+}
+
 func (_m *MockAutoScalingAPI) DescribeTagsPages(_param0 *autoscaling.DescribeTagsInput, _param1 func(*autoscaling.DescribeTagsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeTagsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -748,6 +1470,48 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsPages(arg0, arg1 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeTagsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTagsInput, _param2 func(*autoscaling.DescribeTagsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPagesWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypes(_param0 *autoscaling.DescribeTerminationPolicyTypesInput) (*autoscaling.DescribeTerminationPolicyTypesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypes", _param0)
+	ret0, _ := ret[0].(*autoscaling.DescribeTerminationPolicyTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypes(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypes", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTerminationPolicyTypesInput, _param2 ...request.Option) (*autoscaling.DescribeTerminationPolicyTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeTerminationPolicyTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypesRequest(_param0 *autoscaling.DescribeTerminationPolicyTypesInput) (*request.Request, *autoscaling.DescribeTerminationPolicyTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -759,15 +1523,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypesRequest(ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypes(_param0 *autoscaling.DescribeTerminationPolicyTypesInput) (*autoscaling.DescribeTerminationPolicyTypesOutput, error) {
-	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypes", _param0)
-	ret0, _ := ret[0].(*autoscaling.DescribeTerminationPolicyTypesOutput)
+func (_m *MockAutoScalingAPI) DetachInstances(_param0 *autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DetachInstances", _param0)
+	ret0, _ := ret[0].(*autoscaling.DetachInstancesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypes(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypes", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DetachInstances(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstances", arg0)
+}
+
+func (_m *MockAutoScalingAPI) DetachInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.DetachInstancesInput, _param2 ...request.Option) (*autoscaling.DetachInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstancesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) DetachInstancesRequest(_param0 *autoscaling.DetachInstancesInput) (*request.Request, *autoscaling.DetachInstancesOutput) {
@@ -781,26 +1561,42 @@ func (_mr *_MockAutoScalingAPIRecorder) DetachInstancesRequest(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstancesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DetachInstances(_param0 *autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
-	ret := _m.ctrl.Call(_m, "DetachInstances", _param0)
-	ret0, _ := ret[0].(*autoscaling.DetachInstancesOutput)
+func (_m *MockAutoScalingAPI) DetachLoadBalancerTargetGroups(_param0 *autoscaling.DetachLoadBalancerTargetGroupsInput) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancerTargetGroups", _param0)
+	ret0, _ := ret[0].(*autoscaling.DetachLoadBalancerTargetGroupsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DetachInstances(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstances", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancerTargetGroups(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancerTargetGroups", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DetachLoadBalancersRequest(_param0 *autoscaling.DetachLoadBalancersInput) (*request.Request, *autoscaling.DetachLoadBalancersOutput) {
-	ret := _m.ctrl.Call(_m, "DetachLoadBalancersRequest", _param0)
+func (_m *MockAutoScalingAPI) DetachLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DetachLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancerTargetGroupsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DetachLoadBalancerTargetGroupsRequest(_param0 *autoscaling.DetachLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DetachLoadBalancerTargetGroupsOutput) {
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DetachLoadBalancersOutput)
+	ret1, _ := ret[1].(*autoscaling.DetachLoadBalancerTargetGroupsOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancersRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancersRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancerTargetGroupsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancerTargetGroupsRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DetachLoadBalancers(_param0 *autoscaling.DetachLoadBalancersInput) (*autoscaling.DetachLoadBalancersOutput, error) {
@@ -814,15 +1610,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancers(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancers", arg0)
 }
 
-func (_m *MockAutoScalingAPI) DisableMetricsCollectionRequest(_param0 *autoscaling.DisableMetricsCollectionInput) (*request.Request, *autoscaling.DisableMetricsCollectionOutput) {
-	ret := _m.ctrl.Call(_m, "DisableMetricsCollectionRequest", _param0)
+func (_m *MockAutoScalingAPI) DetachLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.DetachLoadBalancersInput, _param2 ...request.Option) (*autoscaling.DetachLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancersWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DetachLoadBalancersRequest(_param0 *autoscaling.DetachLoadBalancersInput) (*request.Request, *autoscaling.DetachLoadBalancersOutput) {
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.DisableMetricsCollectionOutput)
+	ret1, _ := ret[1].(*autoscaling.DetachLoadBalancersOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollectionRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollectionRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancersRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) DisableMetricsCollection(_param0 *autoscaling.DisableMetricsCollectionInput) (*autoscaling.DisableMetricsCollectionOutput, error) {
@@ -836,15 +1648,31 @@ func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollection(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollection", arg0)
 }
 
-func (_m *MockAutoScalingAPI) EnableMetricsCollectionRequest(_param0 *autoscaling.EnableMetricsCollectionInput) (*request.Request, *autoscaling.EnableMetricsCollectionOutput) {
-	ret := _m.ctrl.Call(_m, "EnableMetricsCollectionRequest", _param0)
+func (_m *MockAutoScalingAPI) DisableMetricsCollectionWithContext(_param0 aws.Context, _param1 *autoscaling.DisableMetricsCollectionInput, _param2 ...request.Option) (*autoscaling.DisableMetricsCollectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisableMetricsCollectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DisableMetricsCollectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollectionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) DisableMetricsCollectionRequest(_param0 *autoscaling.DisableMetricsCollectionInput) (*request.Request, *autoscaling.DisableMetricsCollectionOutput) {
+	ret := _m.ctrl.Call(_m, "DisableMetricsCollectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.EnableMetricsCollectionOutput)
+	ret1, _ := ret[1].(*autoscaling.DisableMetricsCollectionOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollectionRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollectionRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollectionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollectionRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) EnableMetricsCollection(_param0 *autoscaling.EnableMetricsCollectionInput) (*autoscaling.EnableMetricsCollectionOutput, error) {
@@ -858,15 +1686,31 @@ func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollection(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollection", arg0)
 }
 
-func (_m *MockAutoScalingAPI) EnterStandbyRequest(_param0 *autoscaling.EnterStandbyInput) (*request.Request, *autoscaling.EnterStandbyOutput) {
-	ret := _m.ctrl.Call(_m, "EnterStandbyRequest", _param0)
+func (_m *MockAutoScalingAPI) EnableMetricsCollectionWithContext(_param0 aws.Context, _param1 *autoscaling.EnableMetricsCollectionInput, _param2 ...request.Option) (*autoscaling.EnableMetricsCollectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableMetricsCollectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.EnableMetricsCollectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollectionWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) EnableMetricsCollectionRequest(_param0 *autoscaling.EnableMetricsCollectionInput) (*request.Request, *autoscaling.EnableMetricsCollectionOutput) {
+	ret := _m.ctrl.Call(_m, "EnableMetricsCollectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*autoscaling.EnterStandbyOutput)
+	ret1, _ := ret[1].(*autoscaling.EnableMetricsCollectionOutput)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) EnterStandbyRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandbyRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollectionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollectionRequest", arg0)
 }
 
 func (_m *MockAutoScalingAPI) EnterStandby(_param0 *autoscaling.EnterStandbyInput) (*autoscaling.EnterStandbyOutput, error) {
@@ -880,6 +1724,60 @@ func (_mr *_MockAutoScalingAPIRecorder) EnterStandby(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandby", arg0)
 }
 
+func (_m *MockAutoScalingAPI) EnterStandbyWithContext(_param0 aws.Context, _param1 *autoscaling.EnterStandbyInput, _param2 ...request.Option) (*autoscaling.EnterStandbyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnterStandbyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.EnterStandbyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) EnterStandbyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandbyWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) EnterStandbyRequest(_param0 *autoscaling.EnterStandbyInput) (*request.Request, *autoscaling.EnterStandbyOutput) {
+	ret := _m.ctrl.Call(_m, "EnterStandbyRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*autoscaling.EnterStandbyOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) EnterStandbyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandbyRequest", arg0)
+}
+
+func (_m *MockAutoScalingAPI) ExecutePolicy(_param0 *autoscaling.ExecutePolicyInput) (*autoscaling.ExecutePolicyOutput, error) {
+	ret := _m.ctrl.Call(_m, "ExecutePolicy", _param0)
+	ret0, _ := ret[0].(*autoscaling.ExecutePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicy(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicy", arg0)
+}
+
+func (_m *MockAutoScalingAPI) ExecutePolicyWithContext(_param0 aws.Context, _param1 *autoscaling.ExecutePolicyInput, _param2 ...request.Option) (*autoscaling.ExecutePolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ExecutePolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ExecutePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) ExecutePolicyRequest(_param0 *autoscaling.ExecutePolicyInput) (*request.Request, *autoscaling.ExecutePolicyOutput) {
 	ret := _m.ctrl.Call(_m, "ExecutePolicyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -887,19 +1785,35 @@ func (_m *MockAutoScalingAPI) ExecutePolicyRequest(_param0 *autoscaling.ExecuteP
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicyRequest(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicyRequest", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicyRequest", arg0)
+}
+
+func (_m *MockAutoScalingAPI) ExitStandby(_param0 *autoscaling.ExitStandbyInput) (*autoscaling.ExitStandbyOutput, error) {
+	ret := _m.ctrl.Call(_m, "ExitStandby", _param0)
+	ret0, _ := ret[0].(*autoscaling.ExitStandbyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ExitStandby(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandby", arg0)
 }
 
-func (_m *MockAutoScalingAPI) ExecutePolicy(_param0 *autoscaling.ExecutePolicyInput) (*autoscaling.ExecutePolicyOutput, error) {
-	ret := _m.ctrl.Call(_m, "ExecutePolicy", _param0)
-	ret0, _ := ret[0].(*autoscaling.ExecutePolicyOutput)
+func (_m *MockAutoScalingAPI) ExitStandbyWithContext(_param0 aws.Context, _param1 *autoscaling.ExitStandbyInput, _param2 ...request.Option) (*autoscaling.ExitStandbyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ExitStandbyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ExitStandbyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicy(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicy", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) ExitStandbyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandbyWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) ExitStandbyRequest(_param0 *autoscaling.ExitStandbyInput) (*request.Request, *autoscaling.ExitStandbyOutput) {
@@ -913,15 +1827,31 @@ func (_mr *_MockAutoScalingAPIRecorder) ExitStandbyRequest(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandbyRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) ExitStandby(_param0 *autoscaling.ExitStandbyInput) (*autoscaling.ExitStandbyOutput, error) {
-	ret := _m.ctrl.Call(_m, "ExitStandby", _param0)
-	ret0, _ := ret[0].(*autoscaling.ExitStandbyOutput)
+func (_m *MockAutoScalingAPI) PutLifecycleHook(_param0 *autoscaling.PutLifecycleHookInput) (*autoscaling.PutLifecycleHookOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutLifecycleHook", _param0)
+	ret0, _ := ret[0].(*autoscaling.PutLifecycleHookOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) ExitStandby(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandby", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHook(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHook", arg0)
+}
+
+func (_m *MockAutoScalingAPI) PutLifecycleHookWithContext(_param0 aws.Context, _param1 *autoscaling.PutLifecycleHookInput, _param2 ...request.Option) (*autoscaling.PutLifecycleHookOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutLifecycleHookWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutLifecycleHookOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHookWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHookWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) PutLifecycleHookRequest(_param0 *autoscaling.PutLifecycleHookInput) (*request.Request, *autoscaling.PutLifecycleHookOutput) {
@@ -935,15 +1865,31 @@ func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHookRequest(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHookRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) PutLifecycleHook(_param0 *autoscaling.PutLifecycleHookInput) (*autoscaling.PutLifecycleHookOutput, error) {
-	ret := _m.ctrl.Call(_m, "PutLifecycleHook", _param0)
-	ret0, _ := ret[0].(*autoscaling.PutLifecycleHookOutput)
+func (_m *MockAutoScalingAPI) PutNotificationConfiguration(_param0 *autoscaling.PutNotificationConfigurationInput) (*autoscaling.PutNotificationConfigurationOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutNotificationConfiguration", _param0)
+	ret0, _ := ret[0].(*autoscaling.PutNotificationConfigurationOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHook(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHook", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfiguration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfiguration", arg0)
+}
+
+func (_m *MockAutoScalingAPI) PutNotificationConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.PutNotificationConfigurationInput, _param2 ...request.Option) (*autoscaling.PutNotificationConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutNotificationConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutNotificationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfigurationWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) PutNotificationConfigurationRequest(_param0 *autoscaling.PutNotificationConfigurationInput) (*request.Request, *autoscaling.PutNotificationConfigurationOutput) {
@@ -957,15 +1903,31 @@ func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfigurationRequest(arg0
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfigurationRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) PutNotificationConfiguration(_param0 *autoscaling.PutNotificationConfigurationInput) (*autoscaling.PutNotificationConfigurationOutput, error) {
-	ret := _m.ctrl.Call(_m, "PutNotificationConfiguration", _param0)
-	ret0, _ := ret[0].(*autoscaling.PutNotificationConfigurationOutput)
+func (_m *MockAutoScalingAPI) PutScalingPolicy(_param0 *autoscaling.PutScalingPolicyInput) (*autoscaling.PutScalingPolicyOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutScalingPolicy", _param0)
+	ret0, _ := ret[0].(*autoscaling.PutScalingPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfiguration(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfiguration", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicy(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicy", arg0)
+}
+
+func (_m *MockAutoScalingAPI) PutScalingPolicyWithContext(_param0 aws.Context, _param1 *autoscaling.PutScalingPolicyInput, _param2 ...request.Option) (*autoscaling.PutScalingPolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutScalingPolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutScalingPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicyWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) PutScalingPolicyRequest(_param0 *autoscaling.PutScalingPolicyInput) (*request.Request, *autoscaling.PutScalingPolicyOutput) {
@@ -979,15 +1941,31 @@ func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicyRequest(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicyRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) PutScalingPolicy(_param0 *autoscaling.PutScalingPolicyInput) (*autoscaling.PutScalingPolicyOutput, error) {
-	ret := _m.ctrl.Call(_m, "PutScalingPolicy", _param0)
-	ret0, _ := ret[0].(*autoscaling.PutScalingPolicyOutput)
+func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupAction(_param0 *autoscaling.PutScheduledUpdateGroupActionInput) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutScheduledUpdateGroupAction", _param0)
+	ret0, _ := ret[0].(*autoscaling.PutScheduledUpdateGroupActionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicy(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicy", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupAction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupAction", arg0)
+}
+
+func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupActionWithContext(_param0 aws.Context, _param1 *autoscaling.PutScheduledUpdateGroupActionInput, _param2 ...request.Option) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutScheduledUpdateGroupActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutScheduledUpdateGroupActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupActionWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupActionRequest(_param0 *autoscaling.PutScheduledUpdateGroupActionInput) (*request.Request, *autoscaling.PutScheduledUpdateGroupActionOutput) {
@@ -1001,15 +1979,31 @@ func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupActionRequest(arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupActionRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupAction(_param0 *autoscaling.PutScheduledUpdateGroupActionInput) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
-	ret := _m.ctrl.Call(_m, "PutScheduledUpdateGroupAction", _param0)
-	ret0, _ := ret[0].(*autoscaling.PutScheduledUpdateGroupActionOutput)
+func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeat(_param0 *autoscaling.RecordLifecycleActionHeartbeatInput) (*autoscaling.RecordLifecycleActionHeartbeatOutput, error) {
+	ret := _m.ctrl.Call(_m, "RecordLifecycleActionHeartbeat", _param0)
+	ret0, _ := ret[0].(*autoscaling.RecordLifecycleActionHeartbeatOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupAction(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupAction", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeat(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeat", arg0)
+}
+
+func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeatWithContext(_param0 aws.Context, _param1 *autoscaling.RecordLifecycleActionHeartbeatInput, _param2 ...request.Option) (*autoscaling.RecordLifecycleActionHeartbeatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RecordLifecycleActionHeartbeatWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.RecordLifecycleActionHeartbeatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeatWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeatRequest(_param0 *autoscaling.RecordLifecycleActionHeartbeatInput) (*request.Request, *autoscaling.RecordLifecycleActionHeartbeatOutput) {
@@ -1023,15 +2017,31 @@ func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeatRequest(ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeatRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeat(_param0 *autoscaling.RecordLifecycleActionHeartbeatInput) (*autoscaling.RecordLifecycleActionHeartbeatOutput, error) {
-	ret := _m.ctrl.Call(_m, "RecordLifecycleActionHeartbeat", _param0)
-	ret0, _ := ret[0].(*autoscaling.RecordLifecycleActionHeartbeatOutput)
+func (_m *MockAutoScalingAPI) ResumeProcesses(_param0 *autoscaling.ScalingProcessQuery) (*autoscaling.ResumeProcessesOutput, error) {
+	ret := _m.ctrl.Call(_m, "ResumeProcesses", _param0)
+	ret0, _ := ret[0].(*autoscaling.ResumeProcessesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeat(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeat", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) ResumeProcesses(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcesses", arg0)
+}
+
+func (_m *MockAutoScalingAPI) ResumeProcessesWithContext(_param0 aws.Context, _param1 *autoscaling.ScalingProcessQuery, _param2 ...request.Option) (*autoscaling.ResumeProcessesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResumeProcessesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ResumeProcessesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ResumeProcessesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcessesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) ResumeProcessesRequest(_param0 *autoscaling.ScalingProcessQuery) (*request.Request, *autoscaling.ResumeProcessesOutput) {
@@ -1045,15 +2055,31 @@ func (_mr *_MockAutoScalingAPIRecorder) ResumeProcessesRequest(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcessesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) ResumeProcesses(_param0 *autoscaling.ScalingProcessQuery) (*autoscaling.ResumeProcessesOutput, error) {
-	ret := _m.ctrl.Call(_m, "ResumeProcesses", _param0)
-	ret0, _ := ret[0].(*autoscaling.ResumeProcessesOutput)
+func (_m *MockAutoScalingAPI) SetDesiredCapacity(_param0 *autoscaling.SetDesiredCapacityInput) (*autoscaling.SetDesiredCapacityOutput, error) {
+	ret := _m.ctrl.Call(_m, "SetDesiredCapacity", _param0)
+	ret0, _ := ret[0].(*autoscaling.SetDesiredCapacityOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) ResumeProcesses(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcesses", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacity(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacity", arg0)
+}
+
+func (_m *MockAutoScalingAPI) SetDesiredCapacityWithContext(_param0 aws.Context, _param1 *autoscaling.SetDesiredCapacityInput, _param2 ...request.Option) (*autoscaling.SetDesiredCapacityOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetDesiredCapacityWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetDesiredCapacityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacityWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacityWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) SetDesiredCapacityRequest(_param0 *autoscaling.SetDesiredCapacityInput) (*request.Request, *autoscaling.SetDesiredCapacityOutput) {
@@ -1067,15 +2093,31 @@ func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacityRequest(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacityRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) SetDesiredCapacity(_param0 *autoscaling.SetDesiredCapacityInput) (*autoscaling.SetDesiredCapacityOutput, error) {
-	ret := _m.ctrl.Call(_m, "SetDesiredCapacity", _param0)
-	ret0, _ := ret[0].(*autoscaling.SetDesiredCapacityOutput)
+func (_m *MockAutoScalingAPI) SetInstanceHealth(_param0 *autoscaling.SetInstanceHealthInput) (*autoscaling.SetInstanceHealthOutput, error) {
+	ret := _m.ctrl.Call(_m, "SetInstanceHealth", _param0)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceHealthOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacity(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacity", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealth(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealth", arg0)
+}
+
+func (_m *MockAutoScalingAPI) SetInstanceHealthWithContext(_param0 aws.Context, _param1 *autoscaling.SetInstanceHealthInput, _param2 ...request.Option) (*autoscaling.SetInstanceHealthOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetInstanceHealthWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceHealthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealthWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealthWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) SetInstanceHealthRequest(_param0 *autoscaling.SetInstanceHealthInput) (*request.Request, *autoscaling.SetInstanceHealthOutput) {
@@ -1089,15 +2131,31 @@ func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealthRequest(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealthRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) SetInstanceHealth(_param0 *autoscaling.SetInstanceHealthInput) (*autoscaling.SetInstanceHealthOutput, error) {
-	ret := _m.ctrl.Call(_m, "SetInstanceHealth", _param0)
-	ret0, _ := ret[0].(*autoscaling.SetInstanceHealthOutput)
+func (_m *MockAutoScalingAPI) SetInstanceProtection(_param0 *autoscaling.SetInstanceProtectionInput) (*autoscaling.SetInstanceProtectionOutput, error) {
+	ret := _m.ctrl.Call(_m, "SetInstanceProtection", _param0)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceProtectionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealth(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealth", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtection(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtection", arg0)
+}
+
+func (_m *MockAutoScalingAPI) SetInstanceProtectionWithContext(_param0 aws.Context, _param1 *autoscaling.SetInstanceProtectionInput, _param2 ...request.Option) (*autoscaling.SetInstanceProtectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetInstanceProtectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceProtectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtectionWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) SetInstanceProtectionRequest(_param0 *autoscaling.SetInstanceProtectionInput) (*request.Request, *autoscaling.SetInstanceProtectionOutput) {
@@ -1111,15 +2169,31 @@ func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtectionRequest(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtectionRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) SetInstanceProtection(_param0 *autoscaling.SetInstanceProtectionInput) (*autoscaling.SetInstanceProtectionOutput, error) {
-	ret := _m.ctrl.Call(_m, "SetInstanceProtection", _param0)
-	ret0, _ := ret[0].(*autoscaling.SetInstanceProtectionOutput)
+func (_m *MockAutoScalingAPI) SuspendProcesses(_param0 *autoscaling.ScalingProcessQuery) (*autoscaling.SuspendProcessesOutput, error) {
+	ret := _m.ctrl.Call(_m, "SuspendProcesses", _param0)
+	ret0, _ := ret[0].(*autoscaling.SuspendProcessesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtection(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtection", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) SuspendProcesses(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcesses", arg0)
+}
+
+func (_m *MockAutoScalingAPI) SuspendProcessesWithContext(_param0 aws.Context, _param1 *autoscaling.ScalingProcessQuery, _param2 ...request.Option) (*autoscaling.SuspendProcessesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SuspendProcessesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SuspendProcessesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SuspendProcessesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcessesWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) SuspendProcessesRequest(_param0 *autoscaling.ScalingProcessQuery) (*request.Request, *autoscaling.SuspendProcessesOutput) {
@@ -1133,15 +2207,31 @@ func (_mr *_MockAutoScalingAPIRecorder) SuspendProcessesRequest(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcessesRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) SuspendProcesses(_param0 *autoscaling.ScalingProcessQuery) (*autoscaling.SuspendProcessesOutput, error) {
-	ret := _m.ctrl.Call(_m, "SuspendProcesses", _param0)
-	ret0, _ := ret[0].(*autoscaling.SuspendProcessesOutput)
+func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroup(_param0 *autoscaling.TerminateInstanceInAutoScalingGroupInput) (*autoscaling.TerminateInstanceInAutoScalingGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "TerminateInstanceInAutoScalingGroup", _param0)
+	ret0, _ := ret[0].(*autoscaling.TerminateInstanceInAutoScalingGroupOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) SuspendProcesses(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcesses", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroup", arg0)
+}
+
+func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.TerminateInstanceInAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.TerminateInstanceInAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "TerminateInstanceInAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.TerminateInstanceInAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroupWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroupRequest(_param0 *autoscaling.TerminateInstanceInAutoScalingGroupInput) (*request.Request, *autoscaling.TerminateInstanceInAutoScalingGroupOutput) {
@@ -1155,15 +2245,31 @@ func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroupReque
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroupRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroup(_param0 *autoscaling.TerminateInstanceInAutoScalingGroupInput) (*autoscaling.TerminateInstanceInAutoScalingGroupOutput, error) {
-	ret := _m.ctrl.Call(_m, "TerminateInstanceInAutoScalingGroup", _param0)
-	ret0, _ := ret[0].(*autoscaling.TerminateInstanceInAutoScalingGroupOutput)
+func (_m *MockAutoScalingAPI) UpdateAutoScalingGroup(_param0 *autoscaling.UpdateAutoScalingGroupInput) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateAutoScalingGroup", _param0)
+	ret0, _ := ret[0].(*autoscaling.UpdateAutoScalingGroupOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroup(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroup", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroup", arg0)
+}
+
+func (_m *MockAutoScalingAPI) UpdateAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.UpdateAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UpdateAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.UpdateAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroupWithContext", _s...)
 }
 
 func (_m *MockAutoScalingAPI) UpdateAutoScalingGroupRequest(_param0 *autoscaling.UpdateAutoScalingGroupInput) (*request.Request, *autoscaling.UpdateAutoScalingGroupOutput) {
@@ -1177,13 +2283,77 @@ func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroupRequest(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroupRequest", arg0)
 }
 
-func (_m *MockAutoScalingAPI) UpdateAutoScalingGroup(_param0 *autoscaling.UpdateAutoScalingGroupInput) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
-	ret := _m.ctrl.Call(_m, "UpdateAutoScalingGroup", _param0)
-	ret0, _ := ret[0].(*autoscaling.UpdateAutoScalingGroupOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+func (_m *MockAutoScalingAPI) WaitUntilGroupExists(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupExists", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroup(arg0 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroup", arg0)
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupExists(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupExists", arg0)
+}
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupExistsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupExistsWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupInService(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupInService", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupInService(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupInService", arg0)
+}
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupInServiceWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupInServiceWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupInServiceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupInServiceWithContext", _s...)
+}
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupNotExists(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupNotExists", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupNotExists(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupNotExists", arg0)
+}
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupNotExistsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupNotExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupNotExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupNotExistsWithContext", _s...)
 }
@@ -87,3 +87,81 @@ func MockGetQueueVisibleMessagesTimes(t *testing.T, mockMatcher *sdk.MockSQSAPI,
 	}
 	gomock.InOrder(calls...)
 }
+
+// queueURLMatcher matches the SQS API calls of a queue
+type queueURLMatcher string
+
+func (q queueURLMatcher) Matches(x interface{}) bool {
+	switch in := x.(type) {
+	case *sqs.GetQueueAttributesInput:
+		return aws.StringValue(in.QueueUrl) == string(q)
+	}
+	return false
+}
+
+func (q queueURLMatcher) String() string {
+	return "is queue " + string(q)
+}
+
+// MockGetQueuesAttributes mocks the API call of getting the queue attributes from multiple
+// SQS queues, the attributes are set by queue URL
+func MockGetQueuesAttributes(t *testing.T, mockMatcher *sdk.MockSQSAPI, attributes map[string]map[string]string) {
+	log.Logger.Warningf("Mocking AWS iface: GetQueueAttributes")
+
+	for u, attrs := range attributes {
+		result := &sqs.GetQueueAttributesOutput{
+			Attributes: aws.StringMap(attrs),
+		}
+
+		// Mock as expected with our result
+		mockMatcher.EXPECT().GetQueueAttributes(queueURLMatcher(u)).Do(func(input interface{}) {
+			gotInput := input.(*sqs.GetQueueAttributesInput)
+			// Check API received parameters are fine
+			if len(gotInput.AttributeNames) < 1 {
+				t.Fatalf("Expected 1 or more attrs, got %d", len(gotInput.AttributeNames))
+			}
+		}).AnyTimes().Return(result, nil)
+	}
+}
+
+// MockListQueues mocks the API call of listing the SQS queues by name prefix
+func MockListQueues(t *testing.T, mockMatcher *sdk.MockSQSAPI, prefix string, queueURLs ...string) {
+	log.Logger.Warningf("Mocking AWS iface: ListQueues")
+
+	result := &sqs.ListQueuesOutput{
+		QueueUrls: aws.StringSlice(queueURLs),
+	}
+
+	// Mock as expected with our result
+	mockMatcher.EXPECT().ListQueues(gomock.Any()).Do(func(input interface{}) {
+		gotInput := input.(*sqs.ListQueuesInput)
+		// Check API received parameters are fine
+		if aws.StringValue(gotInput.QueueNamePrefix) != prefix {
+			t.Fatalf("Wrong queue name prefix, want: %s; got: %s", prefix, aws.StringValue(gotInput.QueueNamePrefix))
+		}
+	}).AnyTimes().Return(result, nil)
+}
+
+// MockListQueuesError mocks the API call of getting an error listing the SQS queues
+func MockListQueuesError(t *testing.T, mockMatcher *sdk.MockSQSAPI) {
+	log.Logger.Warningf("Mocking AWS iface: ListQueues")
+	mockMatcher.EXPECT().ListQueues(gomock.Any()).AnyTimes().Return(&sqs.ListQueuesOutput{}, errors.New("Wrong!"))
+}
+
+// MockGetQueueURL mocks the API call of getting the SQS queue URLs of an account by name,
+// the URLs are set by queue name
+func MockGetQueueURL(t *testing.T, mockMatcher *sdk.MockSQSAPI, accountID string, queueURLs map[string]string) {
+	log.Logger.Warningf("Mocking AWS iface: GetQueueUrl")
+
+	for n, u := range queueURLs {
+		result := &sqs.GetQueueUrlOutput{
+			QueueUrl: aws.String(u),
+		}
+
+		// Mock as expected with our result
+		mockMatcher.EXPECT().GetQueueUrl(&sqs.GetQueueUrlInput{
+			QueueName:              aws.String(n),
+			QueueOwnerAWSAccountId: aws.String(accountID),
+		}).AnyTimes().Return(result, nil)
+	}
+}
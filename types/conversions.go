@@ -18,3 +18,14 @@ func I2Int64(n interface{}) int64 {
 	}
 	panic(fmt.Sprintf("%v is not a valid int type", n))
 }
+
+// I2Float64 will take an int or float interface and return a float64 value
+func I2Float64(n interface{}) float64 {
+	switch n := n.(type) {
+	case float32:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return float64(I2Int64(n))
+}
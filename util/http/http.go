@@ -0,0 +1,60 @@
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TLSConfig creates a TLS configuration that will trust the CA certificates of caFile
+// (if not empty) besides the system ones, and will skip the server verification if insecure
+func TLSConfig(caFile string, insecure bool) (*tls.Config, error) {
+	cfg := &tls.Config{InsecureSkipVerify: insecure}
+
+	if caFile == "" {
+		return cfg, nil
+	}
+
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no valid CA certificates on %s", caFile)
+	}
+	cfg.RootCAs = pool
+
+	return cfg, nil
+}
+
+// NewTransport creates an HTTP transport with a custom TLS configuration,
+// if tlsCfg is nil the default one will be used
+func NewTransport(tlsCfg *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:     tlsCfg,
+	}
+}
+
+// NewClient creates an HTTP client with a timeout and a custom TLS configuration,
+// if tlsCfg is nil the default one will be used
+func NewClient(timeout time.Duration, tlsCfg *tls.Config) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(tlsCfg),
+		Timeout:   timeout,
+	}
+}
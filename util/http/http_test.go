@@ -0,0 +1,72 @@
+package http
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	// Write the test server CA
+	f, err := ioutil.TempFile("", "ca")
+	if err != nil {
+		t.Fatalf("Error creating CA file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	f.Close()
+
+	// Create an empty file
+	e, err := ioutil.TempFile("", "ca")
+	if err != nil {
+		t.Fatalf("Error creating CA file: %v", err)
+	}
+	defer os.Remove(e.Name())
+	e.Close()
+
+	tests := []struct {
+		caFile   string
+		insecure bool
+
+		wantCreateError  bool
+		wantRequestError bool
+	}{
+		{"", false, false, true},
+		{"", true, false, false},
+		{f.Name(), false, false, false},
+		{e.Name(), false, true, false},
+		{"/tmp/does/not/exist", false, true, false},
+	}
+
+	for _, test := range tests {
+		cfg, err := TLSConfig(test.caFile, test.insecure)
+		if test.wantCreateError {
+			if err == nil {
+				t.Errorf("\n- %+v\n  TLS config creation should give error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("\n- %+v\n  TLS config creation shouldn't give error: %v", test, err)
+			continue
+		}
+
+		c := NewClient(time.Second, cfg)
+		_, err = c.Get(ts.URL)
+		if test.wantRequestError && err == nil {
+			t.Errorf("\n- %+v\n  Request should give error, it didn't", test)
+		}
+		if !test.wantRequestError && err != nil {
+			t.Errorf("\n- %+v\n  Request shouldn't give error: %v", test, err)
+		}
+	}
+}
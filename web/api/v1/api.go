@@ -11,12 +11,14 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/themotion/ladder/autoscaler"
 	"github.com/themotion/ladder/log"
+	"github.com/themotion/ladder/types"
 )
 
 // Routes
 const (
 	cancelStopAutoscalerRT = "/autoscalers/:autoscaler/cancel-stop"
 	stopAutoscalerRT       = "/autoscalers/:autoscaler/stop/:duration"
+	pushInputValueRT       = "/autoscalers/:autoscaler/inputs/:inputter/value"
 	autoscalersRT          = "/autoscalers"
 )
 
@@ -103,6 +105,7 @@ func (a *APIV1) Register(r *httprouter.Router) {
 	r.GET(a.prefix+autoscalersRT, createAPIHandler(a.autoscalersList))
 	r.PUT(a.prefix+cancelStopAutoscalerRT, createAPIHandler(a.cancelStopAutoscaler))
 	r.PUT(a.prefix+stopAutoscalerRT, createAPIHandler(a.stopAutoscaler))
+	r.PUT(a.prefix+pushInputValueRT, createAPIHandler(a.pushInputValue))
 	log.Logger.Infof("Registered API v1 endopoints with prefix: %s", a.prefix)
 }
 
@@ -230,6 +233,72 @@ func (a *APIV1) stopAutoscaler(r *http.Request, ps httprouter.Params) (*apiResul
 	}, nil
 }
 
+// pushInputValue will push a value to the gatherer of an autoscaler inputter
+func (a *APIV1) pushInputValue(r *http.Request, ps httprouter.Params) (*apiResult, *apiError) {
+	aName := ps.ByName("autoscaler")
+	iName := ps.ByName("inputter")
+	log.Logger.Debugf("Called push input value API v1 endpoint on autoscaler: %s, inputter: %s", aName, iName)
+
+	as, ok := a.autoscalers[aName]
+	if !ok {
+		err := fmt.Errorf("%s is not a valid autoscaler", aName)
+		return nil, &apiError{
+			apiResult: apiResult{
+				code: http.StatusBadRequest,
+				data: map[string]string{
+					"msg":        err.Error(),
+					"autoscaler": aName,
+				},
+			},
+			err: err,
+		}
+	}
+
+	// Get the value from the body
+	body := struct {
+		Value *int64 `json:"value"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Value == nil {
+		err := fmt.Errorf("value is not valid")
+		return nil, &apiError{
+			apiResult: apiResult{
+				code: http.StatusBadRequest,
+				data: map[string]string{
+					"msg":        err.Error(),
+					"autoscaler": aName,
+					"inputter":   iName,
+				},
+			},
+			err: err,
+		}
+	}
+
+	// Push
+	if err := as.PushInput(iName, types.Quantity{Q: *body.Value}); err != nil {
+		return nil, &apiError{
+			apiResult: apiResult{
+				code: http.StatusBadRequest,
+				data: map[string]string{
+					"msg":        err.Error(),
+					"autoscaler": aName,
+					"inputter":   iName,
+				},
+			},
+			err: err,
+		}
+	}
+
+	return &apiResult{
+		code: http.StatusOK,
+		data: map[string]interface{}{
+			"msg":        "Input value pushed",
+			"autoscaler": aName,
+			"inputter":   iName,
+			"value":      *body.Value,
+		},
+	}, nil
+}
+
 // stopAutoscaler will stop an autoscaler
 func (a *APIV1) autoscalersList(r *http.Request, ps httprouter.Params) (*apiResult, *apiError) {
 	autoscalers := map[string]interface{}{}